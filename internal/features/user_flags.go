@@ -9,6 +9,7 @@ type UserFeatures struct {
 	KeyVault               KeyVaultFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	NetworkSecurityGroup   NetworkSecurityGroupFeatures
 	ResourceGroup          ResourceGroupFeatures
 }
 
@@ -48,6 +49,10 @@ type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
 
+type NetworkSecurityGroupFeatures struct {
+	FailOnSecurityRuleWarnings bool
+}
+
 type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
 }
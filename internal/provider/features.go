@@ -165,6 +165,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"network_security_group": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"fail_on_security_rule_warnings": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		"template_deployment": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -397,6 +412,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["network_security_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			networkSecurityGroupRaw := items[0].(map[string]interface{})
+			if v, ok := networkSecurityGroupRaw["fail_on_security_rule_warnings"]; ok {
+				featuresMap.NetworkSecurityGroup.FailOnSecurityRuleWarnings = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["resource_group"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -42,6 +42,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				NetworkSecurityGroup: features.NetworkSecurityGroupFeatures{
+					FailOnSecurityRuleWarnings: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -103,6 +106,11 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": true,
 						},
 					},
+					"network_security_group": []interface{}{
+						map[string]interface{}{
+							"fail_on_security_rule_warnings": true,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": true,
@@ -154,6 +162,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				NetworkSecurityGroup: features.NetworkSecurityGroupFeatures{
+					FailOnSecurityRuleWarnings: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
@@ -215,6 +226,11 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": false,
 						},
 					},
+					"network_security_group": []interface{}{
+						map[string]interface{}{
+							"fail_on_security_rule_warnings": false,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": false,
@@ -266,6 +282,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				NetworkSecurityGroup: features.NetworkSecurityGroupFeatures{
+					FailOnSecurityRuleWarnings: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
@@ -961,6 +980,71 @@ func TestExpandFeaturesLogAnalyticsWorkspace(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesNetworkSecurityGroup(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_security_group": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkSecurityGroup: features.NetworkSecurityGroupFeatures{
+					FailOnSecurityRuleWarnings: false,
+				},
+			},
+		},
+		{
+			Name: "Fail On Security Rule Warnings Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_security_group": []interface{}{
+						map[string]interface{}{
+							"fail_on_security_rule_warnings": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkSecurityGroup: features.NetworkSecurityGroupFeatures{
+					FailOnSecurityRuleWarnings: true,
+				},
+			},
+		},
+		{
+			Name: "Fail On Security Rule Warnings Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_security_group": []interface{}{
+						map[string]interface{}{
+							"fail_on_security_rule_warnings": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkSecurityGroup: features.NetworkSecurityGroupFeatures{
+					FailOnSecurityRuleWarnings: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.NetworkSecurityGroup, testCase.Expected.NetworkSecurityGroup) {
+			t.Fatalf("Expected %+v but got %+v", result.NetworkSecurityGroup, testCase.Expected.NetworkSecurityGroup)
+		}
+	}
+}
+
 func TestExpandFeaturesResourceGroup(t *testing.T) {
 	testData := []struct {
 		Name     string
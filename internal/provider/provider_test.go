@@ -80,6 +80,7 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 					// The key vault item resources have longer read timeout for mitigating issue: https://github.com/hashicorp/terraform-provider-azurerm/issues/11059.
 					"azurerm_key_vault_key":         true,
 					"azurerm_key_vault_secret":      true,
+					"azurerm_key_vault_secrets":     true,
 					"azurerm_key_vault_certificate": true,
 				}
 				if !exceptionResources[resourceName] {
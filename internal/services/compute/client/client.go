@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/sshpublickeys"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/disks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2023-03-01/virtualmachineruncommands"
 )
 
 type Client struct {
@@ -40,6 +41,7 @@ type Client struct {
 	VMScaleSetVMsClient              *compute.VirtualMachineScaleSetVMsClient
 	VMClient                         *compute.VirtualMachinesClient
 	VMImageClient                    *compute.VirtualMachineImagesClient
+	VMRunCommandsClient              *virtualmachineruncommands.VirtualMachineRunCommandsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -109,6 +111,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmImageClient := compute.NewVirtualMachineImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmImageClient.Client, o.ResourceManagerAuthorizer)
 
+	vmRunCommandsClient := virtualmachineruncommands.NewVirtualMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vmRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmScaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetClient.Client, o.ResourceManagerAuthorizer)
 
@@ -152,5 +157,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		VMScaleSetVMsClient:              &vmScaleSetVMsClient,
 		VMClient:                         &vmClient,
 		VMImageClient:                    &vmImageClient,
+		VMRunCommandsClient:              &vmRunCommandsClient,
 	}
 }
@@ -0,0 +1,114 @@
+package compute
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-version"
+)
+
+// imageVersionMatchesConstraint returns whether the image version `name` satisfies the version constraints -
+// versions which can't be parsed as a semantic version never match
+func imageVersionMatchesConstraint(name string, constraints version.Constraints) bool {
+	if len(constraints) == 0 {
+		return true
+	}
+
+	v, err := version.NewVersion(name)
+	if err != nil {
+		return false
+	}
+
+	return constraints.Check(v)
+}
+
+type sharedImageVersionFilter struct {
+	// only versions which satisfy these constraints are returned
+	Constraints version.Constraints
+
+	// only versions published at least this long ago are returned
+	MinimumAge time.Duration
+
+	// versions flagged as `exclude_from_latest` are omitted
+	IgnoreExcludedFromLatest bool
+
+	// only versions which have finished replicating to all of these regions are returned
+	ReplicatedToRegions []string
+}
+
+func (f sharedImageVersionFilter) matches(input compute.GalleryImageVersion, now time.Time) bool {
+	if input.Name == nil || !imageVersionMatchesConstraint(*input.Name, f.Constraints) {
+		return false
+	}
+
+	var profile *compute.GalleryImageVersionPublishingProfile
+	if props := input.GalleryImageVersionProperties; props != nil {
+		profile = props.PublishingProfile
+	}
+
+	if f.IgnoreExcludedFromLatest && profile != nil && profile.ExcludeFromLatest != nil && *profile.ExcludeFromLatest {
+		return false
+	}
+
+	if f.MinimumAge > 0 {
+		if profile == nil || profile.PublishedDate == nil || profile.PublishedDate.Time.Add(f.MinimumAge).After(now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sharedImageVersionIsReplicatedTo returns whether replication of the image version has completed in all of the
+// specified regions - this requires that the version was retrieved with the Replication Status expanded
+func sharedImageVersionIsReplicatedTo(input compute.GalleryImageVersion, regions []string) bool {
+	if len(regions) == 0 {
+		return true
+	}
+
+	props := input.GalleryImageVersionProperties
+	if props == nil || props.ReplicationStatus == nil || props.ReplicationStatus.Summary == nil {
+		return false
+	}
+
+	completed := make(map[string]struct{})
+	for _, v := range *props.ReplicationStatus.Summary {
+		if v.Region != nil && v.State == compute.ReplicationStateCompleted {
+			completed[location.Normalize(*v.Region)] = struct{}{}
+		}
+	}
+
+	for _, region := range regions {
+		if _, ok := completed[location.Normalize(region)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// latestPlatformImageVersion returns the most recent of the Platform Image versions satisfying the constraints
+func latestPlatformImageVersion(input []compute.VirtualMachineImageResource, constraints version.Constraints) *compute.VirtualMachineImageResource {
+	var latest *compute.VirtualMachineImageResource
+	var latestVersion *version.Version
+
+	for i := range input {
+		item := input[i]
+		if item.Name == nil {
+			continue
+		}
+
+		v, err := version.NewVersion(*item.Name)
+		if err != nil || !constraints.Check(v) {
+			continue
+		}
+
+		if latestVersion == nil || v.GreaterThan(latestVersion) {
+			latest = &item
+			latestVersion = v
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,200 @@
+package compute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestSharedImageVersionFilterMatches(t *testing.T) {
+	now := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	image := func(name string, published time.Time, excludeFromLatest bool) compute.GalleryImageVersion {
+		return compute.GalleryImageVersion{
+			Name: utils.String(name),
+			GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
+				PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
+					ExcludeFromLatest: utils.Bool(excludeFromLatest),
+					PublishedDate:     &date.Time{Time: published},
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		name     string
+		filter   sharedImageVersionFilter
+		input    compute.GalleryImageVersion
+		expected bool
+	}{
+		{
+			name:     "no filter",
+			filter:   sharedImageVersionFilter{},
+			input:    image("1.0.0", now, true),
+			expected: true,
+		},
+		{
+			name: "matching constraint",
+			filter: sharedImageVersionFilter{
+				Constraints: version.MustConstraints(version.NewConstraint(">= 1.0.0, < 2.0.0")),
+			},
+			input:    image("1.2.3", now, false),
+			expected: true,
+		},
+		{
+			name: "non-matching constraint",
+			filter: sharedImageVersionFilter{
+				Constraints: version.MustConstraints(version.NewConstraint(">= 1.0.0, < 2.0.0")),
+			},
+			input:    image("2.0.0", now, false),
+			expected: false,
+		},
+		{
+			name: "excluded from latest",
+			filter: sharedImageVersionFilter{
+				IgnoreExcludedFromLatest: true,
+			},
+			input:    image("1.0.0", now, true),
+			expected: false,
+		},
+		{
+			name: "not excluded from latest",
+			filter: sharedImageVersionFilter{
+				IgnoreExcludedFromLatest: true,
+			},
+			input:    image("1.0.0", now, false),
+			expected: true,
+		},
+		{
+			name: "old enough",
+			filter: sharedImageVersionFilter{
+				MinimumAge: 7 * 24 * time.Hour,
+			},
+			input:    image("1.0.0", now.Add(-8*24*time.Hour), false),
+			expected: true,
+		},
+		{
+			name: "too new",
+			filter: sharedImageVersionFilter{
+				MinimumAge: 7 * 24 * time.Hour,
+			},
+			input:    image("1.0.0", now.Add(-6*24*time.Hour), false),
+			expected: false,
+		},
+		{
+			name: "no published date",
+			filter: sharedImageVersionFilter{
+				MinimumAge: 7 * 24 * time.Hour,
+			},
+			input: compute.GalleryImageVersion{
+				Name: utils.String("1.0.0"),
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := v.filter.matches(v.input, now)
+		if actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestSharedImageVersionIsReplicatedTo(t *testing.T) {
+	input := compute.GalleryImageVersion{
+		Name: utils.String("1.0.0"),
+		GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
+			ReplicationStatus: &compute.ReplicationStatus{
+				Summary: &[]compute.RegionalReplicationStatus{
+					{
+						Region: utils.String("West Europe"),
+						State:  compute.ReplicationStateCompleted,
+					},
+					{
+						Region: utils.String("North Europe"),
+						State:  compute.ReplicationStateReplicating,
+					},
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		regions  []string
+		expected bool
+	}{
+		{
+			regions:  []string{},
+			expected: true,
+		},
+		{
+			regions:  []string{"westeurope"},
+			expected: true,
+		},
+		{
+			regions:  []string{"westeurope", "northeurope"},
+			expected: false,
+		},
+		{
+			regions:  []string{"eastus"},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %v..", v.regions)
+
+		actual := sharedImageVersionIsReplicatedTo(input, v.regions)
+		if actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestLatestPlatformImageVersion(t *testing.T) {
+	input := []compute.VirtualMachineImageResource{
+		{Name: utils.String("18.04.202209100")},
+		{Name: utils.String("18.04.202209210")},
+		{Name: utils.String("18.04.202210050")},
+		{Name: utils.String("not-a-version")},
+	}
+
+	testData := []struct {
+		constraint string
+		expected   *string
+	}{
+		{
+			constraint: ">= 18.04.0",
+			expected:   utils.String("18.04.202210050"),
+		},
+		{
+			constraint: "< 18.04.202210000",
+			expected:   utils.String("18.04.202209210"),
+		},
+		{
+			constraint: ">= 20.04.0",
+			expected:   nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.constraint)
+
+		actual := latestPlatformImageVersion(input, version.MustConstraints(version.NewConstraint(v.constraint)))
+		if v.expected == nil {
+			if actual != nil {
+				t.Fatalf("Expected no image but got %q", *actual.Name)
+			}
+			continue
+		}
+		if actual == nil || *actual.Name != *v.expected {
+			t.Fatalf("Expected %q but got %+v", *v.expected, actual)
+		}
+	}
+}
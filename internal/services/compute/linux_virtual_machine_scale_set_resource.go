@@ -50,6 +50,10 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 		// https://github.com/Azure/azure-rest-api-specs/pull/7246
 
 		Schema: resourceLinuxVirtualMachineScaleSetSchema(),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			VirtualMachineScaleSetManualUpgradePolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -145,6 +149,10 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
 	}
 
+	if upgradeMode != compute.UpgradeModeManual && len(d.Get("manual_upgrade_policy").([]interface{})) > 0 {
+		return fmt.Errorf("a `manual_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
 	secretsRaw := d.Get("secret").([]interface{})
 	secrets := expandLinuxSecrets(secretsRaw)
 
@@ -778,10 +786,20 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 
 	update.VirtualMachineScaleSetUpdateProperties = &updateProps
 
+	manualUpgradePolicyRaw := d.Get("manual_upgrade_policy").([]interface{})
+	if upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string)); upgradeMode != compute.UpgradeModeManual && len(manualUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("a `manual_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+	manualUpgradePolicy, err := ExpandVirtualMachineScaleSetManualUpgradePolicy(manualUpgradePolicyRaw)
+	if err != nil {
+		return err
+	}
+
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
 		UpdateInstances:              updateInstances,
+		ManualUpgradePolicy:          manualUpgradePolicy,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
 		ID:                           id,
@@ -1280,6 +1298,8 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 
 		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
+		"manual_upgrade_policy": VirtualMachineScaleSetManualUpgradePolicySchema(),
+
 		"max_bid_price": {
 			Type:         pluginsdk.TypeFloat,
 			Optional:     true,
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -39,9 +41,17 @@ func dataSourcePlatformImage() *pluginsdk.Resource {
 			},
 
 			"version": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_constraint"},
+			},
+
+			"version_constraint": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validate.ImageVersionConstraint,
+				ConflictsWith: []string{"version"},
 			},
 		},
 	}
@@ -61,6 +71,9 @@ func dataSourcePlatformImageRead(d *pluginsdk.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("reading Platform Images: %+v", err)
 	}
+	if result.Value == nil || len(*result.Value) == 0 {
+		return fmt.Errorf("no Platform Images were found (location %q / publisher %q / offer %q / sku %q)", location, publisher, offer, sku)
+	}
 
 	var image *compute.VirtualMachineImageResource
 	if v, ok := d.GetOk("version"); ok {
@@ -74,6 +87,16 @@ func dataSourcePlatformImageRead(d *pluginsdk.ResourceData, meta interface{}) er
 		if image == nil {
 			return fmt.Errorf("could not find image (location %q / publisher %q / offer %q / sku %q / version % q): %+v", location, publisher, offer, sku, version, err)
 		}
+	} else if v, ok := d.GetOk("version_constraint"); ok {
+		constraints, err := version.NewConstraint(v.(string))
+		if err != nil {
+			return fmt.Errorf("parsing `version_constraint`: %+v", err)
+		}
+
+		image = latestPlatformImageVersion(*result.Value, constraints)
+		if image == nil {
+			return fmt.Errorf("could not find an image matching the version constraint %q (location %q / publisher %q / offer %q / sku %q)", v.(string), location, publisher, offer, sku)
+		}
 	} else {
 		// get the latest image
 		// the last value is the latest, apparently.
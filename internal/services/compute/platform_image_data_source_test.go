@@ -45,6 +45,20 @@ func TestAccDataSourcePlatformImage_withVersion(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePlatformImage_withVersionConstraint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_platform_image", "test")
+	r := PlatformImageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.withVersionConstraint(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version").HasValue("16.04.201811010"),
+			),
+		},
+	})
+}
+
 func (PlatformImageDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -75,3 +89,19 @@ data "azurerm_platform_image" "test" {
 }
 `, data.Locations.Primary)
 }
+
+func (PlatformImageDataSource) withVersionConstraint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_platform_image" "test" {
+  location           = "%s"
+  publisher          = "Canonical"
+  offer              = "UbuntuServer"
+  sku                = "16.04-LTS"
+  version_constraint = ">= 16.04.201811000, <= 16.04.201811010"
+}
+`, data.Locations.Primary)
+}
@@ -84,5 +84,6 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		GalleryApplicationResource{},
 		GalleryApplicationVersionResource{},
+		VirtualMachineRunCommandResource{},
 	}
 }
@@ -0,0 +1,15 @@
+package virtualmachineruncommands
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualMachineRunCommandsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachineRunCommandsClientWithBaseURI(endpoint string) VirtualMachineRunCommandsClient {
+	return VirtualMachineRunCommandsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
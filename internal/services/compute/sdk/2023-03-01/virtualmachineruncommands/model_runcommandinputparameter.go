@@ -0,0 +1,6 @@
+package virtualmachineruncommands
+
+type RunCommandInputParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,6 @@
+package virtualmachineruncommands
+
+type RunCommandManagedIdentity struct {
+	ClientId *string `json:"clientId,omitempty"`
+	ObjectId *string `json:"objectId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package virtualmachineruncommands
+
+type VirtualMachineRunCommandUpdate struct {
+	Properties *VirtualMachineRunCommandProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                  `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package virtualmachineruncommands
+
+import "fmt"
+
+const defaultApiVersion = "2023-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/virtualmachineruncommands/%s", defaultApiVersion)
+}
@@ -30,9 +30,13 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 		Update: resourceSharedImageVersionCreateUpdate,
 		Delete: resourceSharedImageVersionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.SharedImageVersionID(id)
 			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			// `wait_for_replication` isn't returned by the API, so default it when importing
+			d.Set("wait_for_replication", true)
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -87,17 +91,15 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 							Required: true,
 						},
 
+						// The Service API doesn't support updating `disk_encryption_set_id` or `storage_account_type` for an existing
+						// Target Region, however `ForceNew` can't be used here since the resource would be recreated whenever a
+						// `target_region` is added or removed (as the list indexes shift) - as such this is handled in the `CustomizeDiff`
 						"disk_encryption_set_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validate.DiskEncryptionSetID,
 						},
 
-						// The Service API doesn't support to update `storage_account_type`. So it has to recreate the resource for updating `storage_account_type`.
-						// However, `ForceNew` cannot be used since resource would be recreated while adding or removing `target_region`.
-						// And `CustomizeDiff` also cannot be used since it doesn't support in a `Set`.
-						// So currently terraform would directly return the error message from Service API while updating this property. If this property needs to be updated, please recreate this pluginsdk.
 						"storage_account_type": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
@@ -172,13 +174,53 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"wait_for_replication": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"tags": tags.Schema(),
+
+			"aggregated_replication_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"replication_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"region": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"progress": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"details": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
 			pluginsdk.ForceNewIfChange("end_of_life_date", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) != "" && new.(string) == ""
 			}),
+			sharedImageVersionTargetRegionCustomizeDiff,
 		),
 	}
 }
@@ -202,6 +244,12 @@ func resourceSharedImageVersionCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		if !utils.ResponseWasNotFound(existing.Response) {
 			return tf.ImportAsExistsError("azurerm_shared_image_version", id.ID())
 		}
+	} else {
+		// when `wait_for_replication` is disabled the Image Version may still be replicating from a previous apply,
+		// during which time any changes are rejected by the API with a conflict
+		if err := waitForSharedImageVersionToBeProvisioned(ctx, client, id); err != nil {
+			return err
+		}
 	}
 
 	targetRegions, err := expandSharedImageVersionTargetRegions(d)
@@ -257,8 +305,30 @@ func resourceSharedImageVersionCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	if d.Get("wait_for_replication").(bool) {
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+		}
+	} else {
+		// replicating to every Target Region can take a considerable amount of time, so instead only wait for the
+		// replica in the primary region to become available - the remaining regions continue replicating in the background
+		primaryRegion := azure.NormalizeLocation(d.Get("location").(string))
+		timeout, _ := ctx.Deadline()
+		log.Printf("[DEBUG] Waiting for %s to be replicated to the primary region %q", id, primaryRegion)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending: []string{
+				string(compute.ReplicationStateReplicating),
+				string(compute.ReplicationStateUnknown),
+			},
+			Target:     []string{string(compute.ReplicationStateCompleted)},
+			Refresh:    sharedImageVersionRegionReplicationStateRefreshFunc(ctx, client, id, primaryRegion),
+			MinTimeout: 30 * time.Second,
+			Timeout:    time.Until(timeout),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to be replicated to the primary region %q: %+v", id, primaryRegion, err)
+		}
 	}
 
 	d.SetId(id.ID())
@@ -338,6 +408,17 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("os_disk_snapshot_id", osDiskSnapShotID)
 			d.Set("storage_account_id", storageAccountID)
 		}
+
+		aggregatedReplicationState := ""
+		var replicationStatus *[]compute.RegionalReplicationStatus
+		if status := props.ReplicationStatus; status != nil {
+			aggregatedReplicationState = string(status.AggregatedState)
+			replicationStatus = status.Summary
+		}
+		d.Set("aggregated_replication_state", aggregatedReplicationState)
+		if err := d.Set("replication_status", flattenSharedImageVersionReplicationStatus(replicationStatus)); err != nil {
+			return fmt.Errorf("setting `replication_status`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -353,6 +434,11 @@ func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	// when `wait_for_replication` is disabled the Image Version may still be replicating, which blocks deletion
+	if err := waitForSharedImageVersionToBeProvisioned(ctx, client, *id); err != nil {
+		return err
+	}
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -381,6 +467,48 @@ func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
+// waitForSharedImageVersionToBeProvisioned waits for any in-progress provisioning (e.g. replication to the Target
+// Regions) of the Shared Image Version to finish, since the API rejects changes made during this time
+func waitForSharedImageVersionToBeProvisioned(ctx context.Context, client *compute.GalleryImageVersionsClient, id parse.SharedImageVersionId) error {
+	timeout, _ := ctx.Deadline()
+	log.Printf("[DEBUG] Waiting for the provisioning of %s to finish", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(compute.ProvisioningState3Creating),
+			string(compute.ProvisioningState3Migrating),
+			string(compute.ProvisioningState3Updating),
+		},
+		Target: []string{
+			string(compute.ProvisioningState3Failed),
+			string(compute.ProvisioningState3Succeeded),
+		},
+		Refresh:    sharedImageVersionProvisioningStateRefreshFunc(ctx, client, id),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the provisioning of %s to finish: %+v", id, err)
+	}
+
+	return nil
+}
+
+func sharedImageVersionProvisioningStateRefreshFunc(ctx context.Context, client *compute.GalleryImageVersionsClient, id parse.SharedImageVersionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for the provisioning state of %s: %+v", id, err)
+		}
+
+		if props := res.GalleryImageVersionProperties; props != nil && props.ProvisioningState != "" {
+			return res, string(props.ProvisioningState), nil
+		}
+
+		return res, string(compute.ProvisioningState3Succeeded), nil
+	}
+}
+
 func sharedImageVersionDeleteStateRefreshFunc(ctx context.Context, client *compute.GalleryImageVersionsClient, id parse.SharedImageVersionId) pluginsdk.StateRefreshFunc {
 	// Whilst the Shared Image Version is deleted quickly, it appears it's not actually finished replicating at this time
 	// so the deletion of the parent Shared Image fails with "can not delete until nested resources are deleted"
@@ -399,6 +527,79 @@ func sharedImageVersionDeleteStateRefreshFunc(ctx context.Context, client *compu
 	}
 }
 
+func sharedImageVersionRegionReplicationStateRefreshFunc(ctx context.Context, client *compute.GalleryImageVersionsClient, id parse.SharedImageVersionId, region string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for the replication status of %s: %+v", id, err)
+		}
+
+		if props := res.GalleryImageVersionProperties; props != nil {
+			if props.ProvisioningState == compute.ProvisioningState3Failed {
+				return nil, "", fmt.Errorf("provisioning of %s failed", id)
+			}
+
+			if props.ReplicationStatus != nil && props.ReplicationStatus.Summary != nil {
+				for _, status := range *props.ReplicationStatus.Summary {
+					if status.Region == nil || azure.NormalizeLocation(*status.Region) != region {
+						continue
+					}
+
+					if status.State == compute.ReplicationStateFailed {
+						details := ""
+						if status.Details != nil {
+							details = *status.Details
+						}
+						return nil, "", fmt.Errorf("replication of %s to %q failed: %s", id, region, details)
+					}
+
+					return res, string(status.State), nil
+				}
+			}
+		}
+
+		return res, string(compute.ReplicationStateUnknown), nil
+	}
+}
+
+// sharedImageVersionTargetRegionCustomizeDiff forces a new resource when the `disk_encryption_set_id` or
+// `storage_account_type` of a Target Region which exists both before and after the change is updated, since these
+// can't be changed in-place - Target Regions are matched by `name` so that they can be added and removed in-place
+func sharedImageVersionTargetRegionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("target_region") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("target_region")
+	existing := make(map[string]map[string]interface{})
+	for _, v := range oldRaw.([]interface{}) {
+		if v == nil {
+			continue
+		}
+		region := v.(map[string]interface{})
+		existing[azure.NormalizeLocation(region["name"].(string))] = region
+	}
+
+	for _, v := range newRaw.([]interface{}) {
+		if v == nil {
+			continue
+		}
+		region := v.(map[string]interface{})
+		old, ok := existing[azure.NormalizeLocation(region["name"].(string))]
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"disk_encryption_set_id", "storage_account_type"} {
+			if old[key].(string) != region[key].(string) {
+				return d.ForceNew("target_region")
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandSharedImageVersionTargetRegions(d *pluginsdk.ResourceData) (*[]compute.TargetRegion, error) {
 	vs := d.Get("target_region").([]interface{})
 	results := make([]compute.TargetRegion, 0)
@@ -464,3 +665,35 @@ func flattenSharedImageVersionTargetRegions(input *[]compute.TargetRegion) []int
 
 	return results
 }
+
+func flattenSharedImageVersionReplicationStatus(input *[]compute.RegionalReplicationStatus) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		for _, v := range *input {
+			region := ""
+			if v.Region != nil {
+				region = azure.NormalizeLocation(*v.Region)
+			}
+
+			progress := 0
+			if v.Progress != nil {
+				progress = int(*v.Progress)
+			}
+
+			details := ""
+			if v.Details != nil {
+				details = *v.Details
+			}
+
+			results = append(results, map[string]interface{}{
+				"region":   region,
+				"state":    string(v.State),
+				"progress": progress,
+				"details":  details,
+			})
+		}
+	}
+
+	return results
+}
@@ -230,6 +230,40 @@ func TestAccSharedImageVersion_replicationMode(t *testing.T) {
 	})
 }
 
+func TestAccSharedImageVersion_targetRegionsWithoutWaitingForReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "test")
+	r := SharedImageVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: r.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.withoutWaitingForReplication(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_region.#").HasValue("2"),
+				check.That(data.ResourceName).Key("replication_status.#").Exists(),
+				check.That(data.ResourceName).Key("aggregated_replication_state").Exists(),
+			),
+		},
+		data.ImportStep("wait_for_replication"),
+		{
+			Config: r.withoutWaitingForReplication(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_region.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("wait_for_replication"),
+	})
+}
+
 func TestAccSharedImageVersion_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "test")
 	r := SharedImageVersionResource{}
@@ -544,6 +578,39 @@ resource "azurerm_shared_image_version" "test" {
 `, template, data.Locations.Secondary)
 }
 
+func (r SharedImageVersionResource) withoutWaitingForReplication(data acceptance.TestData, secondaryRegion bool) string {
+	targetRegion := ""
+	if secondaryRegion {
+		targetRegion = fmt.Sprintf(`
+  target_region {
+    name                   = %q
+    regional_replica_count = 1
+  }
+`, data.Locations.Secondary)
+	}
+
+	template := r.provision(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                 = "0.0.1"
+  gallery_name         = azurerm_shared_image_gallery.test.name
+  image_name           = azurerm_shared_image.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = azurerm_resource_group.test.location
+  managed_image_id     = azurerm_image.test.id
+  wait_for_replication = false
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+%s
+}
+`, template, targetRegion)
+}
+
 func (r SharedImageVersionResource) diskEncryptionSetID(data acceptance.TestData) string {
 	template := r.provision(data)
 	return fmt.Sprintf(`
@@ -7,12 +7,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-version"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/rickb777/date/period"
 )
 
 func dataSourceSharedImageVersions() *pluginsdk.Resource {
@@ -40,6 +43,47 @@ func dataSourceSharedImageVersions() *pluginsdk.Resource {
 
 			"tags_filter": tags.Schema(),
 
+			"ignore_excluded_from_latest": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"latest_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"minimum_age": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azValidate.ISO8601Duration,
+			},
+
+			"replicated_to_regions": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:             pluginsdk.TypeString,
+					ValidateFunc:     location.EnhancedValidate,
+					StateFunc:        location.StateFunc,
+					DiffSuppressFunc: location.DiffSuppressFunc,
+				},
+			},
+
+			"sort_versions_by_semver": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"version_constraint": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ImageVersionConstraint,
+			},
+
 			"images": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -119,7 +163,48 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	filter, err := expandSharedImageVersionFilter(d)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	filtered := make([]compute.GalleryImageVersion, 0)
+	for _, image := range images {
+		if !filter.matches(image, now) {
+			continue
+		}
+
+		if len(filter.ReplicatedToRegions) > 0 {
+			// the Replication Status is only returned when retrieving an individual version
+			versionName := *image.Name
+			image, err = client.Get(ctx, resourceGroup, galleryName, imageName, versionName, compute.ReplicationStatusTypesReplicationStatus)
+			if err != nil {
+				return fmt.Errorf("retrieving Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", versionName, imageName, galleryName, resourceGroup, err)
+			}
+			if !sharedImageVersionIsReplicatedTo(image, filter.ReplicatedToRegions) {
+				continue
+			}
+		}
+
+		filtered = append(filtered, image)
+	}
+	images = filtered
+
+	// the API doesn't return the versions in any particular order, so the versions need sorting to find the latest
+	if d.Get("sort_versions_by_semver").(bool) || d.Get("latest_only").(bool) {
+		var errs []error
+		images, errs = sortSharedImageVersions(images)
+		if len(errs) > 0 {
+			return fmt.Errorf("parsing version(s): %v", errs)
+		}
+	}
+
 	flattenedImages := flattenSharedImageVersions(images, filterTags)
+	if d.Get("latest_only").(bool) && len(flattenedImages) > 0 {
+		// the versions are sorted by semver above, so the last image in the list is the latest version
+		flattenedImages = flattenedImages[len(flattenedImages)-1:]
+	}
 	if len(flattenedImages) == 0 {
 		return fmt.Errorf("unable to find any images")
 	}
@@ -137,6 +222,35 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+func expandSharedImageVersionFilter(d *pluginsdk.ResourceData) (*sharedImageVersionFilter, error) {
+	filter := sharedImageVersionFilter{
+		IgnoreExcludedFromLatest: d.Get("ignore_excluded_from_latest").(bool),
+		ReplicatedToRegions:      make([]string, 0),
+	}
+
+	if v, ok := d.GetOk("version_constraint"); ok {
+		constraints, err := version.NewConstraint(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `version_constraint`: %+v", err)
+		}
+		filter.Constraints = constraints
+	}
+
+	if v, ok := d.GetOk("minimum_age"); ok {
+		minimumAge, err := period.Parse(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `minimum_age`: %+v", err)
+		}
+		filter.MinimumAge = minimumAge.DurationApprox()
+	}
+
+	for _, v := range d.Get("replicated_to_regions").([]interface{}) {
+		filter.ReplicatedToRegions = append(filter.ReplicatedToRegions, location.Normalize(v.(string)))
+	}
+
+	return &filter, nil
+}
+
 func flattenSharedImageVersions(input []compute.GalleryImageVersion, filterTags map[string]*string) []interface{} {
 	results := make([]interface{}, 0)
 
@@ -79,6 +79,34 @@ func TestAccDataSourceSharedImageVersions_tagsFilter(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceSharedImageVersions_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_shared_image_versions", "test")
+	r := SharedImageVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config:  SharedImageVersionResource{}.setup(data),
+			Destroy: false,
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").HasValue("1"),
+				check.That(data.ResourceName).Key("images.0.name").HasValue("0.0.1"),
+			),
+		},
+		{
+			Config:      r.filteredTooNew(data),
+			ExpectError: regexp.MustCompile("unable to find any images"),
+		},
+	})
+}
+
 func (SharedImageVersionsDataSource) basic(data acceptance.TestData) string {
 	template := SharedImageVersionResource{}.imageVersion(data)
 	return fmt.Sprintf(`
@@ -125,3 +153,37 @@ data "azurerm_shared_image_versions" "test" {
 }
 `, SharedImageVersionResource{}.imageVersion(data))
 }
+
+func (SharedImageVersionsDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_shared_image_versions" "test" {
+  gallery_name        = azurerm_shared_image_version.test.gallery_name
+  image_name          = azurerm_shared_image_version.test.image_name
+  resource_group_name = azurerm_shared_image_version.test.resource_group_name
+  depends_on          = [azurerm_shared_image_version.test]
+
+  version_constraint          = ">= 0.0.1, < 1.0.0"
+  ignore_excluded_from_latest = true
+  replicated_to_regions       = [azurerm_shared_image_version.test.location]
+  sort_versions_by_semver     = true
+  latest_only                 = true
+}
+`, SharedImageVersionResource{}.imageVersion(data))
+}
+
+func (SharedImageVersionsDataSource) filteredTooNew(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_shared_image_versions" "test" {
+  gallery_name        = azurerm_shared_image_version.test.gallery_name
+  image_name          = azurerm_shared_image_version.test.image_name
+  resource_group_name = azurerm_shared_image_version.test.resource_group_name
+  depends_on          = [azurerm_shared_image_version.test]
+
+  minimum_age = "P7D"
+}
+`, SharedImageVersionResource{}.imageVersion(data))
+}
@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+func ImageVersionConstraint(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string but it wasn't!", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if _, err := version.NewConstraint(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid version constraint such as `>= 1.0.0, < 2.0.0`: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package validate
+
+import "testing"
+
+func TestImageVersionConstraint(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// whitespace
+			input:    "  ",
+			expected: false,
+		},
+		{
+			// exact version
+			input:    "1.0.0",
+			expected: true,
+		},
+		{
+			// range
+			input:    ">= 1.0.0, < 2.0.0",
+			expected: true,
+		},
+		{
+			// pessimistic
+			input:    "~> 18.04.0",
+			expected: true,
+		},
+		{
+			// invalid operator
+			input:    "=> 1.0.0",
+			expected: false,
+		},
+		{
+			// not a version
+			input:    "latest",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ImageVersionConstraint(v.input, "version_constraint")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
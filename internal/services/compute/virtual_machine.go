@@ -135,11 +135,25 @@ func flattenVirtualMachineNetworkInterfaceIDs(input *[]compute.NetworkInterfaceR
 
 	output := make([]interface{}, 0)
 
+	// the first Network Interface is used as the Primary Network Interface when provisioning the Virtual Machine, which
+	// isn't necessarily the order returned from the API (e.g. for Virtual Machines provisioned using `azurerm_virtual_machine`)
+	for _, v := range *input {
+		if v.ID == nil || v.NetworkInterfaceReferenceProperties == nil || v.Primary == nil || !*v.Primary {
+			continue
+		}
+
+		output = append(output, *v.ID)
+	}
+
 	for _, v := range *input {
 		if v.ID == nil {
 			continue
 		}
 
+		if v.NetworkInterfaceReferenceProperties != nil && v.Primary != nil && *v.Primary {
+			continue
+		}
+
 		output = append(output, *v.ID)
 	}
 
@@ -28,8 +28,8 @@ func importVirtualMachine(osType compute.OperatingSystemTypes, resourceType stri
 		}
 
 		isCorrectOS := false
-		if profile := vm.VirtualMachineProperties.StorageProfile; profile != nil {
-			if profile.OsDisk != nil && profile.OsDisk.OsType == osType {
+		if profile := vm.VirtualMachineProperties.StorageProfile; profile != nil && profile.OsDisk != nil {
+			if profile.OsDisk.OsType == osType {
 				isCorrectOS = true
 			}
 
@@ -47,16 +47,17 @@ func importVirtualMachine(osType compute.OperatingSystemTypes, resourceType stri
 			return []*pluginsdk.ResourceData{}, fmt.Errorf("The %q resource doesn't support attaching OS Disks - please use the `azurerm_virtual_machine` resource instead", resourceType)
 		}
 
-		hasSshKeys := false
+		// Virtual Machines provisioned using the legacy `azurerm_virtual_machine` resource can have both SSH Keys and
+		// Password Authentication enabled - since the password isn't returned from the API we need to set a placeholder
+		// whenever Password Authentication is enabled, to avoid the Virtual Machine being recreated after it's imported
+		passwordAuthenticationEnabled := true
 		if osType == compute.OperatingSystemTypesLinux {
-			if linux := vm.VirtualMachineProperties.OsProfile.LinuxConfiguration; linux != nil {
-				if linux.SSH != nil && linux.SSH.PublicKeys != nil {
-					hasSshKeys = len(*linux.SSH.PublicKeys) > 0
-				}
+			if linux := vm.VirtualMachineProperties.OsProfile.LinuxConfiguration; linux != nil && linux.DisablePasswordAuthentication != nil {
+				passwordAuthenticationEnabled = !*linux.DisablePasswordAuthentication
 			}
 		}
 
-		if !hasSshKeys {
+		if passwordAuthenticationEnabled {
 			d.Set("admin_password", "ignored-as-imported")
 		}
 
@@ -0,0 +1,548 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2023-03-01/virtualmachineruncommands"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+
+type VirtualMachineRunCommandModel struct {
+	Name                      string                                      `tfschema:"name"`
+	VirtualMachineId          string                                      `tfschema:"virtual_machine_id"`
+	Location                  string                                      `tfschema:"location"`
+	Source                    []VirtualMachineRunCommandSourceModel       `tfschema:"source"`
+	ErrorBlobManagedIdentity  []VirtualMachineRunCommandIdentityModel     `tfschema:"error_blob_managed_identity"`
+	ErrorBlobUri              string                                      `tfschema:"error_blob_uri"`
+	OutputBlobManagedIdentity []VirtualMachineRunCommandIdentityModel     `tfschema:"output_blob_managed_identity"`
+	OutputBlobUri             string                                      `tfschema:"output_blob_uri"`
+	Parameter                 []VirtualMachineRunCommandParameterModel    `tfschema:"parameter"`
+	ProtectedParameter        []VirtualMachineRunCommandParameterModel    `tfschema:"protected_parameter"`
+	RunAsPassword             string                                      `tfschema:"run_as_password"`
+	RunAsUser                 string                                      `tfschema:"run_as_user"`
+	Tags                      map[string]string                           `tfschema:"tags"`
+	InstanceView              []VirtualMachineRunCommandInstanceViewModel `tfschema:"instance_view"`
+}
+
+type VirtualMachineRunCommandSourceModel struct {
+	CommandId                string                                  `tfschema:"command_id"`
+	Script                   string                                  `tfschema:"script"`
+	ScriptUri                string                                  `tfschema:"script_uri"`
+	ScriptUriManagedIdentity []VirtualMachineRunCommandIdentityModel `tfschema:"script_uri_managed_identity"`
+}
+
+type VirtualMachineRunCommandIdentityModel struct {
+	ClientId string `tfschema:"client_id"`
+	ObjectId string `tfschema:"object_id"`
+}
+
+type VirtualMachineRunCommandParameterModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type VirtualMachineRunCommandInstanceViewModel struct {
+	EndTime          string `tfschema:"end_time"`
+	ErrorMessage     string `tfschema:"error_message"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExecutionState   string `tfschema:"execution_state"`
+	ExitCode         int    `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	StartTime        string `tfschema:"start_time"`
+}
+
+func (r VirtualMachineRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_run_command"
+}
+
+func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineRunCommandModel{}
+}
+
+func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualmachineruncommands.ValidateVirtualMachineRunCommandID
+}
+
+func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script_uri_managed_identity": virtualMachineRunCommandManagedIdentitySchema(),
+				},
+			},
+		},
+
+		"error_blob_managed_identity": virtualMachineRunCommandManagedIdentitySchema(),
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"output_blob_managed_identity": virtualMachineRunCommandManagedIdentitySchema(),
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"parameter": virtualMachineRunCommandParameterSchema(false),
+
+		"protected_parameter": virtualMachineRunCommandParameterSchema(true),
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"run_as_user"},
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			virtualMachineId, err := parse.VirtualMachineID(model.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualmachineruncommands.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, model.Name)
+
+			existing, err := client.GetByVirtualMachine(ctx, id, virtualmachineruncommands.DefaultGetByVirtualMachineOperationOptions())
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := virtualmachineruncommands.VirtualMachineRunCommand{
+				Location:   location.Normalize(model.Location),
+				Properties: expandVirtualMachineRunCommandProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := virtualmachineruncommands.ParseVirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := virtualmachineruncommands.GetByVirtualMachineOperationOptions{
+				Expand: utils.String("instanceView"),
+			}
+			resp, err := client.GetByVirtualMachine(ctx, *id, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the protected parameters, password and any managed identities aren't returned by the API
+			// so we look these up from the existing state
+			var state VirtualMachineRunCommandModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := VirtualMachineRunCommandModel{
+				Name:                      id.RunCommandName,
+				VirtualMachineId:          parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroupName, id.VirtualMachineName).ID(),
+				ErrorBlobManagedIdentity:  state.ErrorBlobManagedIdentity,
+				OutputBlobManagedIdentity: state.OutputBlobManagedIdentity,
+				ProtectedParameter:        state.ProtectedParameter,
+				RunAsPassword:             state.RunAsPassword,
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				model.Location = location.Normalize(respModel.Location)
+				if respModel.Tags != nil {
+					model.Tags = *respModel.Tags
+				}
+
+				if props := respModel.Properties; props != nil {
+					model.ErrorBlobUri = utils.NormalizeNilableString(props.ErrorBlobUri)
+					model.OutputBlobUri = utils.NormalizeNilableString(props.OutputBlobUri)
+					model.Parameter = flattenVirtualMachineRunCommandParameters(props.Parameters)
+					model.RunAsUser = utils.NormalizeNilableString(props.RunAsUser)
+					model.Source = flattenVirtualMachineRunCommandSource(props.Source, state.Source)
+					model.InstanceView = flattenVirtualMachineRunCommandInstanceView(props.InstanceView)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := virtualmachineruncommands.ParseVirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Run Command is re-run when the resource is updated, so we send the complete payload
+			payload := virtualmachineruncommands.VirtualMachineRunCommandUpdate{
+				Properties: expandVirtualMachineRunCommandProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := virtualmachineruncommands.ParseVirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func virtualMachineRunCommandManagedIdentitySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"object_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineRunCommandParameterSchema(sensitive bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeList,
+		Optional:  true,
+		Sensitive: sensitive,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    sensitive,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandProperties(model VirtualMachineRunCommandModel) *virtualmachineruncommands.VirtualMachineRunCommandProperties {
+	props := &virtualmachineruncommands.VirtualMachineRunCommandProperties{
+		ErrorBlobManagedIdentity:  expandVirtualMachineRunCommandManagedIdentity(model.ErrorBlobManagedIdentity),
+		OutputBlobManagedIdentity: expandVirtualMachineRunCommandManagedIdentity(model.OutputBlobManagedIdentity),
+		Parameters:                expandVirtualMachineRunCommandParameters(model.Parameter),
+		ProtectedParameters:       expandVirtualMachineRunCommandParameters(model.ProtectedParameter),
+		Source:                    expandVirtualMachineRunCommandSource(model.Source),
+	}
+
+	if model.ErrorBlobUri != "" {
+		props.ErrorBlobUri = utils.String(model.ErrorBlobUri)
+	}
+	if model.OutputBlobUri != "" {
+		props.OutputBlobUri = utils.String(model.OutputBlobUri)
+	}
+	if model.RunAsUser != "" {
+		props.RunAsUser = utils.String(model.RunAsUser)
+	}
+	if model.RunAsPassword != "" {
+		props.RunAsPassword = utils.String(model.RunAsPassword)
+	}
+
+	return props
+}
+
+func expandVirtualMachineRunCommandSource(input []VirtualMachineRunCommandSourceModel) *virtualmachineruncommands.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	source := input[0]
+	output := &virtualmachineruncommands.VirtualMachineRunCommandScriptSource{
+		ScriptUriManagedIdentity: expandVirtualMachineRunCommandManagedIdentity(source.ScriptUriManagedIdentity),
+	}
+
+	if source.CommandId != "" {
+		output.CommandId = utils.String(source.CommandId)
+	}
+	if source.Script != "" {
+		output.Script = utils.String(source.Script)
+	}
+	if source.ScriptUri != "" {
+		output.ScriptUri = utils.String(source.ScriptUri)
+	}
+
+	return output
+}
+
+func expandVirtualMachineRunCommandManagedIdentity(input []VirtualMachineRunCommandIdentityModel) *virtualmachineruncommands.RunCommandManagedIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &virtualmachineruncommands.RunCommandManagedIdentity{}
+	if input[0].ClientId != "" {
+		output.ClientId = utils.String(input[0].ClientId)
+	}
+	if input[0].ObjectId != "" {
+		output.ObjectId = utils.String(input[0].ObjectId)
+	}
+
+	return output
+}
+
+func expandVirtualMachineRunCommandParameters(input []VirtualMachineRunCommandParameterModel) *[]virtualmachineruncommands.RunCommandInputParameter {
+	output := make([]virtualmachineruncommands.RunCommandInputParameter, 0)
+	for _, v := range input {
+		output = append(output, virtualmachineruncommands.RunCommandInputParameter{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineRunCommandSource(input *virtualmachineruncommands.VirtualMachineRunCommandScriptSource, existing []VirtualMachineRunCommandSourceModel) []VirtualMachineRunCommandSourceModel {
+	if input == nil {
+		return []VirtualMachineRunCommandSourceModel{}
+	}
+
+	source := VirtualMachineRunCommandSourceModel{
+		CommandId: utils.NormalizeNilableString(input.CommandId),
+		Script:    utils.NormalizeNilableString(input.Script),
+		ScriptUri: utils.NormalizeNilableString(input.ScriptUri),
+	}
+
+	// the Managed Identity used to download the script isn't returned by the API
+	if len(existing) > 0 {
+		source.ScriptUriManagedIdentity = existing[0].ScriptUriManagedIdentity
+	}
+
+	return []VirtualMachineRunCommandSourceModel{source}
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]virtualmachineruncommands.RunCommandInputParameter) []VirtualMachineRunCommandParameterModel {
+	output := make([]VirtualMachineRunCommandParameterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, VirtualMachineRunCommandParameterModel{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *virtualmachineruncommands.VirtualMachineRunCommandInstanceView) []VirtualMachineRunCommandInstanceViewModel {
+	if input == nil {
+		return []VirtualMachineRunCommandInstanceViewModel{}
+	}
+
+	instanceView := VirtualMachineRunCommandInstanceViewModel{
+		EndTime:          utils.NormalizeNilableString(input.EndTime),
+		ErrorMessage:     utils.NormalizeNilableString(input.Error),
+		ExecutionMessage: utils.NormalizeNilableString(input.ExecutionMessage),
+		Output:           utils.NormalizeNilableString(input.Output),
+		StartTime:        utils.NormalizeNilableString(input.StartTime),
+	}
+
+	if input.ExecutionState != nil {
+		instanceView.ExecutionState = string(*input.ExecutionState)
+	}
+	if input.ExitCode != nil {
+		instanceView.ExitCode = int(*input.ExitCode)
+	}
+
+	return []VirtualMachineRunCommandInstanceViewModel{instanceView}
+}
@@ -2,7 +2,9 @@ package compute
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
 	identity "github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/rickb777/date/period"
 )
 
 func VirtualMachineScaleSetAdditionalCapabilitiesSchema() *pluginsdk.Schema {
@@ -1767,6 +1770,143 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpg
 	}
 }
 
+func VirtualMachineScaleSetManualUpgradePolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"health_probe_gating_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"health_probe_timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT10M",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+				"max_batch_instance_count": {
+					Type:          pluginsdk.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"manual_upgrade_policy.0.max_batch_instance_percent"},
+				},
+				"max_batch_instance_percent": {
+					Type:          pluginsdk.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(1, 100),
+					ConflictsWith: []string{"manual_upgrade_policy.0.max_batch_instance_count"},
+				},
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"pause_time_between_batches": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
+// virtualMachineScaleSetManualUpgradePolicy controls how the provider rolls the instances within a Scale Set using
+// a `Manual` upgrade mode - this is handled entirely by the provider and isn't sent to the Compute API
+type virtualMachineScaleSetManualUpgradePolicy struct {
+	HealthProbeGatingEnabled            bool
+	HealthProbeTimeout                  time.Duration
+	MaxBatchInstanceCount               int
+	MaxBatchInstancePercent             int
+	MaxUnhealthyUpgradedInstancePercent int
+	PauseTimeBetweenBatches             time.Duration
+}
+
+func ExpandVirtualMachineScaleSetManualUpgradePolicy(input []interface{}) (*virtualMachineScaleSetManualUpgradePolicy, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	healthProbeTimeout, err := period.Parse(raw["health_probe_timeout"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `manual_upgrade_policy.0.health_probe_timeout`: %+v", err)
+	}
+
+	pauseTimeBetweenBatches, err := period.Parse(raw["pause_time_between_batches"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `manual_upgrade_policy.0.pause_time_between_batches`: %+v", err)
+	}
+
+	return &virtualMachineScaleSetManualUpgradePolicy{
+		HealthProbeGatingEnabled:            raw["health_probe_gating_enabled"].(bool),
+		HealthProbeTimeout:                  healthProbeTimeout.DurationApprox(),
+		MaxBatchInstanceCount:               raw["max_batch_instance_count"].(int),
+		MaxBatchInstancePercent:             raw["max_batch_instance_percent"].(int),
+		MaxUnhealthyUpgradedInstancePercent: raw["max_unhealthy_upgraded_instance_percent"].(int),
+		PauseTimeBetweenBatches:             pauseTimeBetweenBatches.DurationApprox(),
+	}, nil
+}
+
+// batchSize returns the number of instances which should be rolled at once, given `total` instances to roll
+func (p *virtualMachineScaleSetManualUpgradePolicy) batchSize(total int) int {
+	size := 1
+	if p != nil {
+		if p.MaxBatchInstanceCount > 0 {
+			size = p.MaxBatchInstanceCount
+		} else if p.MaxBatchInstancePercent > 0 {
+			// round up so that a percentage always results in at least a single instance
+			size = (total*p.MaxBatchInstancePercent + 99) / 100
+		}
+	}
+
+	if size < 1 {
+		size = 1
+	}
+	if total > 0 && size > total {
+		size = total
+	}
+	return size
+}
+
+// VirtualMachineScaleSetManualUpgradePolicyCustomizeDiff ensures that health probe gating is only enabled when
+// the instances have a way of reporting their health, since otherwise each batch would wait out `health_probe_timeout`
+func VirtualMachineScaleSetManualUpgradePolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	policies := d.Get("manual_upgrade_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+	if !policies[0].(map[string]interface{})["health_probe_gating_enabled"].(bool) {
+		return nil
+	}
+
+	// the health of each instance is determined from the Application Health extension, since a load balancer
+	// health probe doesn't report the health of the individual instances - the extensions may reference
+	// resources which are yet to be created
+	if !d.NewValueKnown("extension") {
+		return nil
+	}
+
+	for _, v := range d.Get("extension").(*pluginsdk.Set).List() {
+		extensionRaw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if extensionType := extensionRaw["type"].(string); extensionType == "ApplicationHealthLinux" || extensionType == "ApplicationHealthWindows" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("an `ApplicationHealthLinux` or `ApplicationHealthWindows` extension must be specified when `manual_upgrade_policy.0.health_probe_gating_enabled` is set to `true`")
+}
+
 // TODO remove VirtualMachineScaleSetTerminateNotificationSchema in 4.0
 func VirtualMachineScaleSetTerminateNotificationSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
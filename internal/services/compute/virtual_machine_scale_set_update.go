@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
@@ -22,6 +23,9 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
 
+	// how should the instances be rolled when using a Manual upgrade mode? when nil instances are rolled one at a time
+	ManualUpgradePolicy *virtualMachineScaleSetManualUpgradePolicy
+
 	Client   *client.Client
 	Existing compute.VirtualMachineScaleSet
 	ID       *parse.VirtualMachineScaleSetId
@@ -122,7 +126,6 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 }
 
 func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
-	client := metadata.Client.VMScaleSetClient
 	id := metadata.ID
 
 	log.Printf("[DEBUG] Rolling the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
@@ -139,7 +142,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		props := instance.VirtualMachineScaleSetVMProperties
 		if props != nil && instance.InstanceID != nil {
 			latestModel := props.LatestModelApplied
-			if latestModel != nil || !*latestModel {
+			if latestModel == nil || !*latestModel {
 				instanceIdsToRoll = append(instanceIdsToRoll, *instance.InstanceID)
 			}
 		}
@@ -149,44 +152,125 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 	}
 
-	// TODO: there's a performance enhancement to do batches here, but this is fine for a first pass
-	for _, instanceId := range instanceIdsToRoll {
-		instanceIds := []string{instanceId}
+	policy := metadata.ManualUpgradePolicy
+	batchSize := policy.batchSize(len(instanceIdsToRoll))
+	unhealthyInstanceIds := make([]string, 0)
 
-		log.Printf("[DEBUG] Updating Instance %q to the Latest Configuration..", instanceId)
-		ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
-			InstanceIds: &instanceIds,
+	for i := 0; i < len(instanceIdsToRoll); i += batchSize {
+		end := i + batchSize
+		if end > len(instanceIdsToRoll) {
+			end = len(instanceIdsToRoll)
 		}
-		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
-		if err != nil {
-			return fmt.Errorf("updating Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		instanceIds := instanceIdsToRoll[i:end]
+
+		if i > 0 && policy != nil && policy.PauseTimeBetweenBatches > 0 {
+			log.Printf("[DEBUG] Pausing for %s before rolling the next batch of Instances..", policy.PauseTimeBetweenBatches)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("waiting to roll the next batch of Instances for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, ctx.Err())
+			case <-time.After(policy.PauseTimeBetweenBatches):
+			}
 		}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for update of Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		if err := metadata.rollInstances(ctx, instanceIds); err != nil {
+			return err
 		}
-		log.Printf("[DEBUG] Updated Instance %q to the Latest Configuration.", instanceId)
 
-		// TODO: does this want to be a separate, user-configurable toggle?
-		log.Printf("[DEBUG] Reimaging Instance %q..", instanceId)
-		reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
-			InstanceIds: &instanceIds,
+		if policy == nil || !policy.HealthProbeGatingEnabled {
+			continue
 		}
-		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
-		if err != nil {
-			return fmt.Errorf("reimaging Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+
+		for _, instanceId := range instanceIds {
+			healthy, err := metadata.waitForInstanceToBeHealthy(ctx, instanceId, policy.HealthProbeTimeout)
+			if err != nil {
+				return err
+			}
+			if !healthy {
+				unhealthyInstanceIds = append(unhealthyInstanceIds, instanceId)
+			}
 		}
 
-		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for reimage of Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		if len(unhealthyInstanceIds)*100 > policy.MaxUnhealthyUpgradedInstancePercent*len(instanceIdsToRoll) {
+			return fmt.Errorf("aborting rolling the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q) since %d of the %d upgraded Instances (%s) were unhealthy, exceeding `max_unhealthy_upgraded_instance_percent` of %d", metadata.OSType, id.Name, id.ResourceGroup, len(unhealthyInstanceIds), end, strings.Join(unhealthyInstanceIds, ", "), policy.MaxUnhealthyUpgradedInstancePercent)
 		}
-		log.Printf("[DEBUG] Reimaged Instance %q..", instanceId)
 	}
 
 	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
 	return nil
 }
 
+// rollInstances updates the specified Instances to the latest model of the Scale Set and then reimages them
+func (metadata virtualMachineScaleSetUpdateMetaData) rollInstances(ctx context.Context, instanceIds []string) error {
+	client := metadata.Client.VMScaleSetClient
+	id := metadata.ID
+	instances := strings.Join(instanceIds, ", ")
+
+	log.Printf("[DEBUG] Updating Instances %q to the Latest Configuration..", instances)
+	ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
+	if err != nil {
+		return fmt.Errorf("updating Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instances, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instances, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Instances %q to the Latest Configuration.", instances)
+
+	// TODO: does this want to be a separate, user-configurable toggle?
+	log.Printf("[DEBUG] Reimaging Instances %q..", instances)
+	reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
+		InstanceIds: &instanceIds,
+	}
+	reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
+	if err != nil {
+		return fmt.Errorf("reimaging Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", instances, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for reimage of Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", instances, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Reimaged Instances %q.", instances)
+
+	return nil
+}
+
+// waitForInstanceToBeHealthy polls the Instance View of the specified Instance until the Application Health reported
+// by the Application Health Extension is healthy, returning false if this doesn't happen within `timeout`
+func (metadata virtualMachineScaleSetUpdateMetaData) waitForInstanceToBeHealthy(ctx context.Context, instanceId string, timeout time.Duration) (bool, error) {
+	client := metadata.Client.VMScaleSetVMsClient
+	id := metadata.ID
+
+	log.Printf("[DEBUG] Waiting for Instance %q to report as healthy..", instanceId)
+	deadline := time.Now().Add(timeout)
+	for {
+		resp, err := client.GetInstanceView(ctx, id.ResourceGroup, id.Name, instanceId)
+		if err != nil {
+			return false, fmt.Errorf("retrieving Instance View for Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+
+		if health := resp.VMHealth; health != nil && health.Status != nil && health.Status.Code != nil {
+			if strings.EqualFold(*health.Status.Code, "HealthState/healthy") {
+				log.Printf("[DEBUG] Instance %q is healthy.", instanceId)
+				return true, nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			log.Printf("[DEBUG] Instance %q didn't report as healthy within %s.", instanceId, timeout)
+			return false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("waiting for Instance %q (%s VM Scale Set %q / Resource Group %q) to become healthy: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, ctx.Err())
+		case <-time.After(15 * time.Second):
+		}
+	}
+}
+
 func isUsingLatestImage(update compute.VirtualMachineScaleSetUpdate) bool {
 	if update.VirtualMachineProfile.StorageProfile == nil ||
 		update.VirtualMachineProfile.StorageProfile.ImageReference == nil ||
@@ -0,0 +1,92 @@
+package compute
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineScaleSetManualUpgradePolicyBatchSize(t *testing.T) {
+	testData := []struct {
+		name     string
+		policy   *virtualMachineScaleSetManualUpgradePolicy
+		total    int
+		expected int
+	}{
+		{
+			name:     "no policy",
+			policy:   nil,
+			total:    10,
+			expected: 1,
+		},
+		{
+			name:     "empty policy",
+			policy:   &virtualMachineScaleSetManualUpgradePolicy{},
+			total:    10,
+			expected: 1,
+		},
+		{
+			name: "instance count",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstanceCount: 3,
+			},
+			total:    10,
+			expected: 3,
+		},
+		{
+			name: "instance count larger than total",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstanceCount: 30,
+			},
+			total:    10,
+			expected: 10,
+		},
+		{
+			name: "instance percent",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstancePercent: 20,
+			},
+			total:    10,
+			expected: 2,
+		},
+		{
+			name: "instance percent rounds up",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstancePercent: 20,
+			},
+			total:    3,
+			expected: 1,
+		},
+		{
+			name: "instance percent rounds up partial",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstancePercent: 50,
+			},
+			total:    5,
+			expected: 3,
+		},
+		{
+			name: "all instances",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstancePercent: 100,
+			},
+			total:    7,
+			expected: 7,
+		},
+		{
+			name: "no instances",
+			policy: &virtualMachineScaleSetManualUpgradePolicy{
+				MaxBatchInstancePercent: 50,
+			},
+			total:    0,
+			expected: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := v.policy.batchSize(v.total)
+		if actual != v.expected {
+			t.Fatalf("Expected a batch size of %d but got %d", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenVirtualMachineNetworkInterfaceIDs(t *testing.T) {
+	nic := func(id string, primary *bool) compute.NetworkInterfaceReference {
+		output := compute.NetworkInterfaceReference{
+			ID: utils.String(id),
+		}
+		if primary != nil {
+			output.NetworkInterfaceReferenceProperties = &compute.NetworkInterfaceReferenceProperties{
+				Primary: primary,
+			}
+		}
+		return output
+	}
+
+	testData := []struct {
+		name     string
+		input    *[]compute.NetworkInterfaceReference
+		expected []interface{}
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: []interface{}{},
+		},
+		{
+			name: "single without properties",
+			input: &[]compute.NetworkInterfaceReference{
+				nic("nic1", nil),
+			},
+			expected: []interface{}{"nic1"},
+		},
+		{
+			name: "primary first",
+			input: &[]compute.NetworkInterfaceReference{
+				nic("nic1", utils.Bool(true)),
+				nic("nic2", utils.Bool(false)),
+			},
+			expected: []interface{}{"nic1", "nic2"},
+		},
+		{
+			name: "primary last",
+			input: &[]compute.NetworkInterfaceReference{
+				nic("nic1", utils.Bool(false)),
+				nic("nic2", nil),
+				nic("nic3", utils.Bool(true)),
+			},
+			expected: []interface{}{"nic3", "nic1", "nic2"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := flattenVirtualMachineNetworkInterfaceIDs(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
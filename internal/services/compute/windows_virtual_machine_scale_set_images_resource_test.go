@@ -133,6 +133,28 @@ func TestAccWindowsVirtualMachineScaleSet_imagesManualUpdate(t *testing.T) {
 	})
 }
 
+func TestAccWindowsVirtualMachineScaleSet_imagesManualUpdateBatched(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine_scale_set", "test")
+	r := WindowsVirtualMachineScaleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.imagesManualUpdateBatched(data, "2016-Datacenter"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password", "manual_upgrade_policy"),
+		{
+			Config: r.imagesManualUpdateBatched(data, "2019-Datacenter"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password", "manual_upgrade_policy"),
+	})
+}
+
 func TestAccWindowsVirtualMachineScaleSet_imagesManualUpdateExternalRoll(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine_scale_set", "test")
 	r := WindowsVirtualMachineScaleSetResource{}
@@ -552,6 +574,50 @@ resource "azurerm_windows_virtual_machine_scale_set" "test" {
 `, r.template(data), version)
 }
 
+func (r WindowsVirtualMachineScaleSetResource) imagesManualUpdateBatched(data acceptance.TestData, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine_scale_set" "test" {
+  name                = local.vm_name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard_F2"
+  instances           = 3
+  admin_username      = "adminuser"
+  admin_password      = "P@ssword1234!"
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "%s"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  manual_upgrade_policy {
+    max_batch_instance_percent = 50
+    pause_time_between_batches = "PT1M"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+}
+`, r.template(data), version)
+}
+
 func (r WindowsVirtualMachineScaleSetResource) imagesManualUpdateExternalRoll(data acceptance.TestData, version string) string {
 	return fmt.Sprintf(`
 %s
@@ -49,6 +49,10 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 		// https://github.com/Azure/azure-rest-api-specs/pull/7246
 
 		Schema: resourceWindowsVirtualMachineScaleSetSchema(),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			VirtualMachineScaleSetManualUpgradePolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -142,6 +146,10 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
 	}
 
+	if upgradeMode != compute.UpgradeModeManual && len(d.Get("manual_upgrade_policy").([]interface{})) > 0 {
+		return fmt.Errorf("a `manual_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
 	winRmListenersRaw := d.Get("winrm_listener").(*pluginsdk.Set).List()
 	winRmListeners := expandWinRMListener(winRmListenersRaw)
 
@@ -795,10 +803,20 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 
 	update.VirtualMachineScaleSetUpdateProperties = &updateProps
 
+	manualUpgradePolicyRaw := d.Get("manual_upgrade_policy").([]interface{})
+	if upgradeMode != compute.UpgradeModeManual && len(manualUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("a `manual_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+	manualUpgradePolicy, err := ExpandVirtualMachineScaleSetManualUpgradePolicy(manualUpgradePolicyRaw)
+	if err != nil {
+		return err
+	}
+
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
 		UpdateInstances:              updateInstances,
+		ManualUpgradePolicy:          manualUpgradePolicy,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
 		ID:                           id,
@@ -1328,6 +1346,8 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 			},
 		},
 
+		"manual_upgrade_policy": VirtualMachineScaleSetManualUpgradePolicySchema(),
+
 		"max_bid_price": {
 			Type:         pluginsdk.TypeFloat,
 			Optional:     true,
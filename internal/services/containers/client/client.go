@@ -6,6 +6,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2021-03-01/containerinstance"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-03-01/extensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-03-01/fluxconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -18,12 +20,15 @@ type Client struct {
 	RegistriesClient                  *containerregistry.RegistriesClient
 	ReplicationsClient                *containerregistry.ReplicationsClient
 	ServicesClient                    *legacy.ContainerServicesClient
+	SnapshotsClient                   *containerservice.SnapshotsClient
 	WebhooksClient                    *containerregistry.WebhooksClient
 	TokensClient                      *containerregistry.TokensClient
 	ScopeMapsClient                   *containerregistry.ScopeMapsClient
 	TasksClient                       *containerregistry.TasksClient
 	RunsClient                        *containerregistry.RunsClient
 	ConnectedRegistriesClient         *containerregistry.ConnectedRegistriesClient
+	KubernetesExtensionsClient        *extensions.ExtensionsClient
+	FluxConfigurationClient           *fluxconfiguration.FluxConfigurationClient
 
 	Environment azure.Environment
 }
@@ -66,6 +71,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	snapshotsClient := containerservice.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
+	kubernetesExtensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kubernetesExtensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	fluxConfigurationClient := fluxconfiguration.NewFluxConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fluxConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -82,11 +96,14 @@ func NewClient(o *common.ClientOptions) *Client {
 		WebhooksClient:                    &webhooksClient,
 		ReplicationsClient:                &replicationsClient,
 		ServicesClient:                    &servicesClient,
+		SnapshotsClient:                   &snapshotsClient,
 		Environment:                       o.Environment,
 		TokensClient:                      &tokensClient,
 		ScopeMapsClient:                   &scopeMapsClient,
 		TasksClient:                       &tasksClient,
 		RunsClient:                        &runsClient,
 		ConnectedRegistriesClient:         &connectedRegistriesClient,
+		KubernetesExtensionsClient:        &kubernetesExtensionsClient,
+		FluxConfigurationClient:           &fluxConfigurationClient,
 	}
 }
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -34,6 +35,35 @@ type userItemAAD struct {
 
 type userAAD struct {
 	AuthProvider authProvider `yaml:"auth-provider"`
+	Exec         *exec        `yaml:"exec,omitempty"`
+}
+
+type exec struct {
+	APIVersion         string        `yaml:"apiVersion"`
+	Command            string        `yaml:"command"`
+	Args               []string      `yaml:"args,omitempty"`
+	Env                []execEnvItem `yaml:"env,omitempty"`
+	InstallHint        string        `yaml:"installHint,omitempty"`
+	ProvideClusterInfo bool          `yaml:"provideClusterInfo,omitempty"`
+}
+
+type execEnvItem struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// ArgumentValue returns the value passed to the exec plugin for the specified flag, supporting both
+// the `--flag value` and `--flag=value` forms - or an empty string if the flag isn't specified.
+func (e exec) ArgumentValue(flag string) string {
+	for i, arg := range e.Args {
+		if arg == flag && i+1 < len(e.Args) {
+			return e.Args[i+1]
+		}
+		if strings.HasPrefix(arg, flag+"=") {
+			return strings.TrimPrefix(arg, flag+"=")
+		}
+	}
+	return ""
 }
 
 type authProvider struct {
@@ -122,3 +152,17 @@ func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
 
 	return &kubeConfig, nil
 }
+
+func ParseKubeConfigExec(config string) (*KubeConfigAAD, error) {
+	kubeConfig, err := ParseKubeConfigAAD(config)
+	if err != nil {
+		return nil, err
+	}
+
+	e := kubeConfig.Users[0].User.Exec
+	if e == nil || e.Command == "" {
+		return nil, fmt.Errorf("Config requires an exec plugin command for user %+v", kubeConfig.Users[0])
+	}
+
+	return kubeConfig, nil
+}
@@ -192,3 +192,114 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigExec(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		expected   *KubeConfigAAD
+	}{
+		{
+			sourceFile: "user_with_exec.yml",
+			expected: &KubeConfigAAD{
+				KubeConfigBase: KubeConfigBase{
+					APIVersion: "v1",
+					Clusters: []clusterItem{
+						{
+							Name: "test-cluster",
+							Cluster: cluster{
+								ClusterAuthorityData: "test-cluster-authority-data",
+								Server:               "https://testcluster.org:443",
+							},
+						},
+					},
+					Contexts: []contextItem{
+						{
+							Name: "test-cluster",
+							Context: context{
+								Cluster: "test-cluster",
+								User:    "clusterUser_test-rg_test-cluster",
+							},
+						},
+					},
+					CurrentContext: "test-cluster",
+					Kind:           "Config",
+					Preferences:    map[string]interface{}{},
+				},
+				Users: []userItemAAD{
+					{
+						Name: "clusterUser_test-rg_test-cluster",
+						User: userAAD{
+							Exec: &exec{
+								APIVersion: "client.authentication.k8s.io/v1beta1",
+								Command:    "kubelogin",
+								Args: []string{
+									"get-token",
+									"--environment",
+									"AzurePublicCloud",
+									"--server-id",
+									"test-server-id",
+									"--client-id",
+									"test-client-id",
+									"--tenant-id=test-tenant-id",
+									"--login",
+									"devicecode",
+								},
+								InstallHint: "install kubelogin",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			sourceFile: "user_with_exec_no_command.yml",
+		},
+		{
+			sourceFile: "user_with_token.yml",
+		},
+		{
+			sourceFile: "cluster_with_no_server.yml",
+		},
+	}
+
+	for i, test := range testCases {
+		encodedConfig := LoadConfig(test.sourceFile)
+		if len(encodedConfig) == 0 {
+			t.Fatalf("Test case [%d]: Failed to read config from file '%+v' \n",
+				i, test.sourceFile)
+		}
+
+		result, err := ParseKubeConfigExec(encodedConfig)
+		if test.expected == nil {
+			if err == nil {
+				t.Fatalf("Test case [%d]: expected config '%+v' to throw error but didn't", i, test.sourceFile)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test case [%d]: Failed, config '%+v' with error: '%+v'", i, test.sourceFile, err)
+		}
+		if !reflect.DeepEqual(*test.expected, *result) {
+			t.Fatalf("Test case [%d]: expected '%+v' but got '%+v'", i, *test.expected, *result)
+		}
+	}
+}
+
+func TestExecArgumentValue(t *testing.T) {
+	e := exec{
+		Args: []string{"get-token", "--server-id", "test-server-id", "--tenant-id=test-tenant-id", "--login"},
+	}
+
+	testCases := map[string]string{
+		"--server-id": "test-server-id",
+		"--tenant-id": "test-tenant-id",
+		"--client-id": "",
+		"--login":     "",
+	}
+
+	for flag, expected := range testCases {
+		if actual := e.ArgumentValue(flag); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, flag, actual)
+		}
+	}
+}
@@ -248,6 +248,10 @@ func TestAccKubernetesCluster_roleBasedAccessControlAADManagedWithLocalAccountDi
 			Config: r.roleBasedAccessControlAADManagedConfigWithLocalAccountDisabled(data, clientData.TenantID),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kube_config_exec.#").HasValue("1"),
+				check.That(data.ResourceName).Key("kube_config_exec.0.host").Exists(),
+				check.That(data.ResourceName).Key("kube_config_exec.0.command").HasValue("kubelogin"),
+				check.That(data.ResourceName).Key("kube_config_exec.0.server_id").Exists(),
 			),
 		},
 		data.ImportStep("azure_active_directory_role_based_access_control.0.server_app_secret"),
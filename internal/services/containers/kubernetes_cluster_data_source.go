@@ -471,6 +471,65 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"kube_config_exec": {
+				Type:      pluginsdk.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"username": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"env": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"server_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"kube_config_raw": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -595,6 +654,11 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"workload_identity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"role_based_access_control_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -734,6 +798,12 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("setting `microsoft_defender`: %+v", err)
 		}
 
+		workloadIdentityEnabled := false
+		if props.SecurityProfile != nil && props.SecurityProfile.WorkloadIdentity != nil && props.SecurityProfile.WorkloadIdentity.Enabled != nil {
+			workloadIdentityEnabled = *props.SecurityProfile.WorkloadIdentity.Enabled
+		}
+		d.Set("workload_identity_enabled", workloadIdentityEnabled)
+
 		aadRbac := flattenKubernetesClusterDataSourceAzureActiveDirectoryRoleBasedAccessControl(props)
 		if err := d.Set("azure_active_directory_role_based_access_control", aadRbac); err != nil {
 			return fmt.Errorf("setting `azure_active_directory_role_based_access_control`: %+v", err)
@@ -760,6 +830,18 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("kube_admin_config_raw", "")
 			d.Set("kube_admin_config", []interface{}{})
 		}
+
+		kubeConfigExec := make([]interface{}, 0)
+		if props.AadProfile != nil {
+			credentials, err := client.ListClusterUserCredentials(ctx, id.ResourceGroup, id.ManagedClusterName, "", containerservice.FormatExec)
+			if err != nil {
+				return fmt.Errorf("retrieving exec-based User Credentials for %s: %+v", id, err)
+			}
+			kubeConfigExec = flattenKubernetesClusterCredentialsExec(credentials)
+		}
+		if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+			return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+		}
 	}
 
 	identity, err := flattenClusterDataSourceIdentity(resp.Identity)
@@ -0,0 +1,405 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-03-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterExtensionModel struct {
+	Name                           string                               `tfschema:"name"`
+	ClusterId                      string                               `tfschema:"cluster_id"`
+	ExtensionType                  string                               `tfschema:"extension_type"`
+	ReleaseTrain                   string                               `tfschema:"release_train"`
+	ReleaseNamespace               string                               `tfschema:"release_namespace"`
+	TargetNamespace                string                               `tfschema:"target_namespace"`
+	ConfigurationSettings          map[string]string                    `tfschema:"configuration_settings"`
+	ConfigurationProtectedSettings map[string]string                    `tfschema:"configuration_protected_settings"`
+	Version                        string                               `tfschema:"version"`
+	AksAssignedIdentity            []KubernetesClusterExtensionIdentity `tfschema:"aks_assigned_identity"`
+	CurrentVersion                 string                               `tfschema:"current_version"`
+}
+
+type KubernetesClusterExtensionIdentity struct {
+	Type        string `tfschema:"type"`
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+}
+
+type KubernetesClusterExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterExtensionResource{}
+
+func (r KubernetesClusterExtensionResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_extension"
+}
+
+func (r KubernetesClusterExtensionResource) ModelObject() interface{} {
+	return &KubernetesClusterExtensionModel{}
+}
+
+func (r KubernetesClusterExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return extensions.ValidateExtensionID
+}
+
+func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([-.a-zA-Z0-9]{0,251}[a-zA-Z0-9])?$`),
+				"`name` must be between 1 and 253 characters, may only contain alphanumeric characters, hyphens and periods, and must start and end with an alphanumeric character",
+			),
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"extension_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"configuration_protected_settings": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"configuration_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"release_train": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"release_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Computed:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"target_namespace"},
+		},
+
+		"target_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Computed:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"release_namespace"},
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"aks_assigned_identity": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"current_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KubernetesClusterExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			clusterId, err := parse.ClusterID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := extensions.NewExtensionID(clusterId.SubscriptionId, clusterId.ResourceGroup, "Microsoft.ContainerService", "managedClusters", clusterId.ManagedClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := &extensions.Extension{
+				Identity: &identity.SystemAssigned{
+					Type: identity.TypeSystemAssigned,
+				},
+				Properties: &extensions.ExtensionProperties{
+					AutoUpgradeMinorVersion:        utils.Bool(model.Version == ""),
+					ConfigurationProtectedSettings: &model.ConfigurationProtectedSettings,
+					ConfigurationSettings:          &model.ConfigurationSettings,
+					ExtensionType:                  &model.ExtensionType,
+				},
+			}
+
+			if model.ReleaseTrain != "" {
+				properties.Properties.ReleaseTrain = &model.ReleaseTrain
+			}
+
+			if model.ReleaseNamespace != "" {
+				properties.Properties.Scope = &extensions.Scope{
+					Cluster: &extensions.ScopeCluster{
+						ReleaseNamespace: &model.ReleaseNamespace,
+					},
+				}
+			}
+
+			if model.TargetNamespace != "" {
+				properties.Properties.Scope = &extensions.Scope{
+					Namespace: &extensions.ScopeNamespace{
+						TargetNamespace: &model.TargetNamespace,
+					},
+				}
+			}
+
+			if model.Version != "" {
+				properties.Properties.Version = &model.Version
+			}
+
+			if err := client.CreateThenPoll(ctx, id, *properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := extensions.PatchExtension{
+				Properties: &extensions.PatchExtensionProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("configuration_protected_settings") {
+				properties.Properties.ConfigurationProtectedSettings = &model.ConfigurationProtectedSettings
+			}
+
+			if metadata.ResourceData.HasChange("configuration_settings") {
+				properties.Properties.ConfigurationSettings = &model.ConfigurationSettings
+			}
+
+			if metadata.ResourceData.HasChange("release_train") {
+				properties.Properties.ReleaseTrain = &model.ReleaseTrain
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				properties.Properties.AutoUpgradeMinorVersion = utils.Bool(model.Version == "")
+				if model.Version != "" {
+					properties.Properties.Version = &model.Version
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the protected settings aren't returned by the API, so these are retained from the state
+			var state KubernetesClusterExtensionModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := KubernetesClusterExtensionModel{
+				Name:                           id.ExtensionName,
+				ClusterId:                      parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID(),
+				ConfigurationProtectedSettings: state.ConfigurationProtectedSettings,
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					model.AksAssignedIdentity = flattenKubernetesClusterExtensionIdentity(props.AksAssignedIdentity)
+					model.ConfigurationSettings = flattenKubernetesClusterExtensionSettings(props.ConfigurationSettings)
+
+					if props.ExtensionType != nil {
+						model.ExtensionType = *props.ExtensionType
+					}
+
+					if props.ReleaseTrain != nil {
+						model.ReleaseTrain = *props.ReleaseTrain
+					}
+
+					if props.InstalledVersion != nil {
+						model.CurrentVersion = *props.InstalledVersion
+					}
+
+					// the version is only returned when pinned, otherwise it's managed through auto-upgrade
+					if props.AutoUpgradeMinorVersion != nil && !*props.AutoUpgradeMinorVersion && props.Version != nil {
+						model.Version = *props.Version
+					}
+
+					if scope := props.Scope; scope != nil {
+						if scope.Cluster != nil && scope.Cluster.ReleaseNamespace != nil {
+							model.ReleaseNamespace = *scope.Cluster.ReleaseNamespace
+						}
+
+						if scope.Namespace != nil && scope.Namespace.TargetNamespace != nil {
+							model.TargetNamespace = *scope.Namespace.TargetNamespace
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, extensions.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenKubernetesClusterExtensionIdentity(input *extensions.ExtensionPropertiesAksAssignedIdentity) []KubernetesClusterExtensionIdentity {
+	if input == nil {
+		return []KubernetesClusterExtensionIdentity{}
+	}
+
+	output := KubernetesClusterExtensionIdentity{}
+
+	if input.Type != nil {
+		output.Type = string(*input.Type)
+	}
+
+	if input.PrincipalId != nil {
+		output.PrincipalId = *input.PrincipalId
+	}
+
+	if input.TenantId != nil {
+		output.TenantId = *input.TenantId
+	}
+
+	return []KubernetesClusterExtensionIdentity{output}
+}
+
+func flattenKubernetesClusterExtensionSettings(input *map[string]string) map[string]string {
+	if input == nil {
+		return map[string]string{}
+	}
+
+	return *input
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
@@ -50,6 +51,10 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			0: migration.KubernetesClusterNodePoolV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			forceNewNodePoolIfNotRotating(""),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -77,7 +82,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"vm_size": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -236,7 +240,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"os_sku": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true, // defaults to Ubuntu if using Linux
 				ValidateFunc: validation.StringInSlice([]string{
 					string(containerservice.OSSKUUbuntu),
@@ -288,6 +291,13 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.SpotMaxPrice,
 			},
 
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.SnapshotID,
+			},
+
 			"scale_down_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -298,6 +308,12 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"temporary_name_for_rotation": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: containerValidate.KubernetesAgentPoolName,
+			},
+
 			"ultra_ssd_enabled": {
 				Type:     pluginsdk.TypeBool,
 				ForceNew: true,
@@ -439,6 +455,21 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		// when a version isn't specified the Node Pool runs the same version as the Control Plane
+		desiredVersion := orchestratorVersion
+		if desiredVersion == "" && cluster.ManagedClusterProperties != nil && cluster.ManagedClusterProperties.KubernetesVersion != nil {
+			desiredVersion = *cluster.ManagedClusterProperties.KubernetesVersion
+		}
+		if err := validateNodePoolSnapshotSupportsVersion(ctx, containersClient, snapshotId, desiredVersion); err != nil {
+			return err
+		}
+
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	zones := zones.Expand(d.Get("zones").(*schema.Set).List())
 	if len(zones) > 0 {
 		profile.AvailabilityZones = &zones
@@ -695,15 +726,34 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.MinCount = nil
 	}
 
-	log.Printf("[DEBUG] Updating existing %s..", *id)
 	existing.ManagedClusterAgentPoolProfileProperties = props
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, existing)
-	if err != nil {
-		return fmt.Errorf("updating Node Pool %s: %+v", *id, err)
-	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	if d.HasChanges(nodePoolRotationProperties...) {
+		// these can only be changed by cycling the Node Pool, which the diff ensures is opted into
+		temporaryName := d.Get("temporary_name_for_rotation").(string)
+		if temporaryName == "" {
+			return fmt.Errorf("`temporary_name_for_rotation` must be specified when changing any of the following properties: %s", strings.Join(nodePoolRotationProperties, ", "))
+		}
+
+		props.VMSize = utils.String(d.Get("vm_size").(string))
+		if osSku := d.Get("os_sku").(string); osSku != "" {
+			props.OsSKU = containerservice.OSSKU(osSku)
+		}
+
+		log.Printf("[DEBUG] Rotating existing %s using the temporary Node Pool %q..", *id, temporaryName)
+		if err := rotateNodePool(ctx, client, *id, temporaryName, existing); err != nil {
+			return fmt.Errorf("rotating %s: %+v", *id, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Updating existing %s..", *id)
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, existing)
+		if err != nil {
+			return fmt.Errorf("updating Node Pool %s: %+v", *id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+		}
 	}
 
 	d.Partial(false)
@@ -852,6 +902,16 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("os_disk_type", osDiskType)
 		d.Set("os_type", string(props.OsType))
 		d.Set("os_sku", string(props.OsSKU))
+
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			id, err := parse.SnapshotID(*props.CreationData.SourceResourceID)
+			if err != nil {
+				return err
+			}
+			snapshotId = id.ID()
+		}
+		d.Set("snapshot_id", snapshotId)
 		d.Set("pod_subnet_id", props.PodSubnetID)
 
 		// not returned from the API if not Spot
@@ -337,6 +337,30 @@ func TestAccKubernetesClusterNodePool_manualScaleVMSku(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationConfig(data, "Standard_DS2_v2", "Ubuntu"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("temporary_name_for_rotation"),
+		{
+			Config: r.rotationConfig(data, "Standard_DS3_v2", "CBLMariner"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vm_size").HasValue("Standard_DS3_v2"),
+				check.That(data.ResourceName).Key("os_sku").HasValue("CBLMariner"),
+			),
+		},
+		data.ImportStep("temporary_name_for_rotation"),
+	})
+}
+
 func TestAccKubernetesClusterNodePool_modeSystem(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
@@ -1372,6 +1396,25 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, r.templateConfig(data), sku)
 }
 
+func (r KubernetesClusterNodePoolResource) rotationConfig(data acceptance.TestData, sku, osSku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                        = "internal"
+  kubernetes_cluster_id       = azurerm_kubernetes_cluster.test.id
+  vm_size                     = "%s"
+  os_sku                      = "%s"
+  node_count                  = 1
+  temporary_name_for_rotation = "internaltmp"
+}
+`, r.templateConfig(data), sku, osSku)
+}
+
 func (r KubernetesClusterNodePoolResource) modeSystemConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -60,6 +60,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("windows_profile.0.gmsa.0.root_domain", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != "" && new == ""
 			}),
+			forceNewNodePoolIfNotRotating("default_node_pool.0."),
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -670,6 +671,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"workload_identity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"private_fqdn": { // privateFqdn
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -994,6 +1001,65 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"kube_config_exec": {
+				Type:      pluginsdk.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"username": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"env": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"server_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"kube_config_raw": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -1093,6 +1159,16 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if snapshotId := d.Get("default_node_pool.0.snapshot_id").(string); snapshotId != "" {
+		desiredVersion := kubernetesVersion
+		if nodePoolVersion := agentProfile.ManagedClusterAgentPoolProfileProperties.OrchestratorVersion; nodePoolVersion != nil {
+			desiredVersion = *nodePoolVersion
+		}
+		if err := validateNodePoolSnapshotSupportsVersion(ctx, meta.(*clients.Client).Containers, snapshotId, desiredVersion); err != nil {
+			return fmt.Errorf("validating `default_node_pool.0.snapshot_id`: %+v", err)
+		}
+	}
+
 	var addonProfiles *map[string]*containerservice.ManagedClusterAddonProfile
 	addOns := collectKubernetesAddons(d)
 	addonProfiles, err = expandKubernetesAddOns(d, addOns, env)
@@ -1163,7 +1239,20 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	microsoftDefenderRaw := d.Get("microsoft_defender").([]interface{})
-	microsoftDefender := expandKubernetesClusterMicrosoftDefender(d, microsoftDefenderRaw)
+	securityProfile := expandKubernetesClusterMicrosoftDefender(d, microsoftDefenderRaw)
+
+	if d.Get("workload_identity_enabled").(bool) {
+		if !enableOidcIssuer {
+			return fmt.Errorf("`oidc_issuer_enabled` must be set to `true` to enable `workload_identity_enabled`")
+		}
+
+		if securityProfile == nil {
+			securityProfile = &containerservice.ManagedClusterSecurityProfile{}
+		}
+		securityProfile.WorkloadIdentity = &containerservice.ManagedClusterSecurityProfileWorkloadIdentity{
+			Enabled: utils.Bool(true),
+		}
+	}
 
 	parameters := containerservice.ManagedCluster{
 		Name:             utils.String(id.ManagedClusterName),
@@ -1190,7 +1279,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			DisableLocalAccounts:   utils.Bool(d.Get("local_account_disabled").(bool)),
 			HTTPProxyConfig:        httpProxyConfig,
 			OidcIssuerProfile:      oidcIssuerProfile,
-			SecurityProfile:        microsoftDefender,
+			SecurityProfile:        securityProfile,
 		},
 		Tags: tags.Expand(t),
 	}
@@ -1650,10 +1739,32 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.SecurityProfile = microsoftDefender
 	}
 
+	if d.HasChange("workload_identity_enabled") {
+		updateCluster = true
+	}
+
 	if updateCluster {
-		// If Defender was explicitly disabled in a prior update then we should strip security profile from the request
+		// If Defender was explicitly disabled in a prior update then we should strip the Defender settings from the request
 		// body to prevent errors in cases where Defender is disabled for the entire subscription
-		if !d.HasChanges("microsoft_defender") && len(d.Get("microsoft_defender").([]interface{})) == 0 {
+		if !d.HasChanges("microsoft_defender") && len(d.Get("microsoft_defender").([]interface{})) == 0 && existing.ManagedClusterProperties.SecurityProfile != nil {
+			existing.ManagedClusterProperties.SecurityProfile.AzureDefender = nil
+		}
+
+		// the Security Profile is rebuilt when `microsoft_defender` changes, so Workload Identity is always re-applied here
+		workloadIdentityEnabled := d.Get("workload_identity_enabled").(bool)
+		if workloadIdentityEnabled && !d.Get("oidc_issuer_enabled").(bool) {
+			return fmt.Errorf("`oidc_issuer_enabled` must be set to `true` to enable `workload_identity_enabled`")
+		}
+		if workloadIdentityEnabled || d.HasChange("workload_identity_enabled") {
+			if existing.ManagedClusterProperties.SecurityProfile == nil {
+				existing.ManagedClusterProperties.SecurityProfile = &containerservice.ManagedClusterSecurityProfile{}
+			}
+			existing.ManagedClusterProperties.SecurityProfile.WorkloadIdentity = &containerservice.ManagedClusterSecurityProfileWorkloadIdentity{
+				Enabled: utils.Bool(workloadIdentityEnabled),
+			}
+		}
+
+		if profile := existing.ManagedClusterProperties.SecurityProfile; profile != nil && profile.AzureDefender == nil && profile.WorkloadIdentity == nil && profile.AzureKeyVaultKms == nil {
 			existing.ManagedClusterProperties.SecurityProfile = nil
 		}
 
@@ -1723,13 +1834,31 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 			}
 		}
 
-		agentPool, err := nodePoolsClient.CreateOrUpdate(ctx, defaultNodePoolId.ResourceGroup, defaultNodePoolId.ManagedClusterName, defaultNodePoolId.AgentPoolName, agentProfile)
-		if err != nil {
-			return fmt.Errorf("updating Default Node Pool %s %+v", defaultNodePoolId, err)
+		rotationProperties := make([]string, 0)
+		for _, property := range nodePoolRotationProperties {
+			rotationProperties = append(rotationProperties, fmt.Sprintf("default_node_pool.0.%s", property))
 		}
 
-		if err := agentPool.WaitForCompletionRef(ctx, nodePoolsClient.Client); err != nil {
-			return fmt.Errorf("waiting for update of Default Node Pool %s: %+v", defaultNodePoolId, err)
+		if d.HasChanges(rotationProperties...) {
+			// these can only be changed by cycling the Default Node Pool, which the diff ensures is opted into
+			temporaryName := d.Get("default_node_pool.0.temporary_name_for_rotation").(string)
+			if temporaryName == "" {
+				return fmt.Errorf("`default_node_pool.0.temporary_name_for_rotation` must be specified when changing any of the following properties: %s", strings.Join(rotationProperties, ", "))
+			}
+
+			log.Printf("[DEBUG] Rotating Default Node Pool using the temporary Node Pool %q..", temporaryName)
+			if err := rotateNodePool(ctx, nodePoolsClient, defaultNodePoolId, temporaryName, agentProfile); err != nil {
+				return fmt.Errorf("rotating Default Node Pool %s: %+v", defaultNodePoolId, err)
+			}
+		} else {
+			agentPool, err := nodePoolsClient.CreateOrUpdate(ctx, defaultNodePoolId.ResourceGroup, defaultNodePoolId.ManagedClusterName, defaultNodePoolId.AgentPoolName, agentProfile)
+			if err != nil {
+				return fmt.Errorf("updating Default Node Pool %s %+v", defaultNodePoolId, err)
+			}
+
+			if err := agentPool.WaitForCompletionRef(ctx, nodePoolsClient.Client); err != nil {
+				return fmt.Errorf("waiting for update of Default Node Pool %s: %+v", defaultNodePoolId, err)
+			}
 		}
 		log.Printf("[DEBUG] Updated Default Node Pool.")
 	}
@@ -1919,6 +2048,12 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `microsoft_defender`: %+v", err)
 		}
 
+		workloadIdentityEnabled := false
+		if props.SecurityProfile != nil && props.SecurityProfile.WorkloadIdentity != nil && props.SecurityProfile.WorkloadIdentity.Enabled != nil {
+			workloadIdentityEnabled = *props.SecurityProfile.WorkloadIdentity.Enabled
+		}
+		d.Set("workload_identity_enabled", workloadIdentityEnabled)
+
 		// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled
 		if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
 			adminProfile, err := client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterAdmin")
@@ -1935,6 +2070,19 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("kube_admin_config_raw", "")
 			d.Set("kube_admin_config", []interface{}{})
 		}
+
+		// the exec-based kubeconfig is only relevant for clusters integrated with AAD, where authentication is handled by kubelogin
+		kubeConfigExec := make([]interface{}, 0)
+		if props.AadProfile != nil {
+			credentials, err := client.ListClusterUserCredentials(ctx, id.ResourceGroup, id.ManagedClusterName, "", containerservice.FormatExec)
+			if err != nil {
+				return fmt.Errorf("retrieving exec-based User Credentials for %s: %+v", *id, err)
+			}
+			kubeConfigExec = flattenKubernetesClusterCredentialsExec(credentials)
+		}
+		if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+			return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+		}
 	}
 
 	identity, err := flattenClusterIdentity(resp.Identity)
@@ -2754,6 +2902,50 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 	}
 }
 
+func flattenKubernetesClusterCredentialsExec(input containerservice.CredentialResults) []interface{} {
+	if input.Kubeconfigs == nil || len(*input.Kubeconfigs) == 0 {
+		return []interface{}{}
+	}
+
+	// the first kubeconfig is the one for the cluster's public FQDN
+	raw := (*input.Kubeconfigs)[0].Value
+	if raw == nil {
+		return []interface{}{}
+	}
+
+	// as with `kube_config`, a kubeconfig which can't be parsed leaves this empty rather than failing the read
+	config, err := kubernetes.ParseKubeConfigExec(string(*raw))
+	if err != nil {
+		log.Printf("[DEBUG] parsing exec-based kubeconfig: %+v", err)
+		return []interface{}{}
+	}
+
+	// we don't size-check these since they're validated in the Parse method
+	cluster := config.Clusters[0].Cluster
+	name := config.Users[0].Name
+	execConfig := *config.Users[0].User.Exec
+
+	env := make(map[string]interface{})
+	for _, item := range execConfig.Env {
+		env[item.Name] = item.Value
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"api_version":            execConfig.APIVersion,
+			"args":                   utils.FlattenStringSlice(&execConfig.Args),
+			"client_id":              execConfig.ArgumentValue("--client-id"),
+			"cluster_ca_certificate": cluster.ClusterAuthorityData,
+			"command":                execConfig.Command,
+			"env":                    env,
+			"host":                   cluster.Server,
+			"server_id":              execConfig.ArgumentValue("--server-id"),
+			"tenant_id":              execConfig.ArgumentValue("--tenant-id"),
+			"username":               name,
+		},
+	}
+}
+
 func flattenClusterIdentity(input *containerservice.ManagedClusterIdentity) (*[]interface{}, error) {
 	var transform *identity.SystemOrUserAssignedMap
 
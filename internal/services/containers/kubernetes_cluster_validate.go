@@ -312,3 +312,32 @@ func validateNodePoolSupportsVersion(ctx context.Context, client *client.Client,
 
 	return nil
 }
+
+// validateNodePoolSnapshotSupportsVersion confirms that the Node Pool Snapshot was taken from a Node Pool running the
+// desired version of Kubernetes, since the node image contained within the Snapshot is specific to that version
+func validateNodePoolSnapshotSupportsVersion(ctx context.Context, client *client.Client, snapshotId string, desiredNodePoolVersion string) error {
+	id, err := parse.SnapshotID(snapshotId)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := client.SnapshotsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if desiredNodePoolVersion == "" || snapshot.SnapshotProperties == nil || snapshot.SnapshotProperties.KubernetesVersion == nil {
+		return nil
+	}
+
+	snapshotVersion := *snapshot.SnapshotProperties.KubernetesVersion
+	// alias versions (major.minor) are also fine as the latest supported GA patch version is chosen automatically in this case
+	if snapshotVersion == desiredNodePoolVersion {
+		return nil
+	}
+	if i := strings.LastIndex(snapshotVersion, "."); i != -1 && snapshotVersion[:i] == desiredNodePoolVersion {
+		return nil
+	}
+
+	return fmt.Errorf("the Orchestrator Version %q doesn't match the Kubernetes Version %q of %s - Node Pools created from a Snapshot must use the same version of Kubernetes as the Snapshot", desiredNodePoolVersion, snapshotVersion, *id)
+}
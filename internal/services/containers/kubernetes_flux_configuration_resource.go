@@ -0,0 +1,818 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-03-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fluxConfigurationReferenceTypeBranch = "branch"
+	fluxConfigurationReferenceTypeCommit = "commit"
+	fluxConfigurationReferenceTypeSemver = "semver"
+	fluxConfigurationReferenceTypeTag    = "tag"
+
+	// these are the keys within the `configurationProtectedSettings` which Flux reads the source credentials from
+	fluxConfigurationProtectedSettingHTTPSKey         = "httpsKey"
+	fluxConfigurationProtectedSettingSSHPrivateKey    = "sshPrivateKey"
+	fluxConfigurationProtectedSettingBucketSecretKey  = "bucketSecretKey"
+	fluxConfigurationDefaultIntervalAndTimeoutSeconds = 600
+)
+
+type KubernetesFluxConfigurationModel struct {
+	Name                            string                                     `tfschema:"name"`
+	ClusterId                       string                                     `tfschema:"cluster_id"`
+	Namespace                       string                                     `tfschema:"namespace"`
+	Scope                           string                                     `tfschema:"scope"`
+	GitRepository                   []KubernetesFluxConfigurationGitRepository `tfschema:"git_repository"`
+	Bucket                          []KubernetesFluxConfigurationBucket        `tfschema:"bucket"`
+	Kustomizations                  []KubernetesFluxConfigurationKustomization `tfschema:"kustomizations"`
+	ContinuousReconciliationEnabled bool                                       `tfschema:"continuous_reconciliation_enabled"`
+}
+
+type KubernetesFluxConfigurationGitRepository struct {
+	Url                   string `tfschema:"url"`
+	ReferenceType         string `tfschema:"reference_type"`
+	ReferenceValue        string `tfschema:"reference_value"`
+	HttpsCACertBase64     string `tfschema:"https_ca_cert_base64"`
+	HttpsUser             string `tfschema:"https_user"`
+	HttpsKeyBase64        string `tfschema:"https_key_base64"`
+	SshPrivateKeyBase64   string `tfschema:"ssh_private_key_base64"`
+	SshKnownHostsBase64   string `tfschema:"ssh_known_hosts_base64"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int64  `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int64  `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationBucket struct {
+	Url                   string `tfschema:"url"`
+	BucketName            string `tfschema:"bucket_name"`
+	AccessKey             string `tfschema:"access_key"`
+	SecretKeyBase64       string `tfschema:"secret_key_base64"`
+	TlsEnabled            bool   `tfschema:"tls_enabled"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int64  `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int64  `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationKustomization struct {
+	Name                   string   `tfschema:"name"`
+	Path                   string   `tfschema:"path"`
+	TimeoutInSeconds       int64    `tfschema:"timeout_in_seconds"`
+	SyncIntervalInSeconds  int64    `tfschema:"sync_interval_in_seconds"`
+	RetryIntervalInSeconds int64    `tfschema:"retry_interval_in_seconds"`
+	Force                  bool     `tfschema:"recreating_enabled"`
+	Prune                  bool     `tfschema:"garbage_collection_enabled"`
+	DependsOn              []string `tfschema:"depends_on"`
+}
+
+type KubernetesFluxConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesFluxConfigurationResource{}
+
+func (r KubernetesFluxConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_flux_configuration"
+}
+
+func (r KubernetesFluxConfigurationResource) ModelObject() interface{} {
+	return &KubernetesFluxConfigurationModel{}
+}
+
+func (r KubernetesFluxConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fluxconfiguration.ValidateFluxConfigurationID
+}
+
+func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	kubernetesNameValidation := validation.StringMatch(
+		regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`),
+		"must be between 1 and 63 characters, may only contain lowercase alphanumeric characters and hyphens, and must start and end with an alphanumeric character",
+	)
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: kubernetesNameValidation,
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"namespace": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: kubernetesNameValidation,
+		},
+
+		"kustomizations": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: kubernetesNameValidation,
+					},
+
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+
+					"retry_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+
+					"recreating_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"garbage_collection_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"depends_on": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: kubernetesNameValidation,
+						},
+					},
+				},
+			},
+		},
+
+		"bucket": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"bucket", "git_repository"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"bucket_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(3, 63),
+					},
+
+					"access_key": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						RequiredWith:  []string{"bucket.0.secret_key_base64"},
+						ConflictsWith: []string{"bucket.0.local_auth_reference"},
+					},
+
+					"secret_key_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsBase64,
+						RequiredWith: []string{"bucket.0.access_key"},
+					},
+
+					"tls_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  kubernetesNameValidation,
+						ConflictsWith: []string{"bucket.0.access_key"},
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+				},
+			},
+		},
+
+		"git_repository": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"bucket", "git_repository"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^(http|https|ssh)://`),
+							"`url` must start with `http://`, `https://` or `ssh://`",
+						),
+					},
+
+					"reference_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							fluxConfigurationReferenceTypeBranch,
+							fluxConfigurationReferenceTypeCommit,
+							fluxConfigurationReferenceTypeSemver,
+							fluxConfigurationReferenceTypeTag,
+						}, false),
+					},
+
+					"reference_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"https_ca_cert_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsBase64,
+						RequiredWith: []string{"git_repository.0.https_user"},
+					},
+
+					"https_user": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						RequiredWith:  []string{"git_repository.0.https_key_base64"},
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+					},
+
+					"https_key_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsBase64,
+						RequiredWith: []string{"git_repository.0.https_user"},
+					},
+
+					"ssh_private_key_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ValidateFunc:  validation.StringIsBase64,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.https_user"},
+					},
+
+					"ssh_known_hosts_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  kubernetesNameValidation,
+						ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.ssh_private_key_base64"},
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      fluxConfigurationDefaultIntervalAndTimeoutSeconds,
+						ValidateFunc: validation.IntBetween(1, 35791394),
+					},
+				},
+			},
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(fluxconfiguration.ScopeTypeNamespace),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(fluxconfiguration.ScopeTypeCluster),
+				string(fluxconfiguration.ScopeTypeNamespace),
+			}, false),
+		},
+
+		"continuous_reconciliation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			clusterId, err := parse.ClusterID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := fluxconfiguration.NewFluxConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroup, "Microsoft.ContainerService", "managedClusters", clusterId.ManagedClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := fluxconfiguration.FluxConfiguration{
+				Properties: &fluxconfiguration.FluxConfigurationProperties{
+					Kustomizations: expandKubernetesFluxConfigurationKustomizations(model.Kustomizations),
+					Namespace:      &model.Namespace,
+					Scope:          utils.ToPtr(fluxconfiguration.ScopeType(model.Scope)),
+					Suspend:        utils.Bool(!model.ContinuousReconciliationEnabled),
+				},
+			}
+
+			if err := expandKubernetesFluxConfigurationSource(model, properties.Properties); err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			// removed Kustomizations can't be expressed through a PATCH, so the whole configuration is sent instead
+			if metadata.ResourceData.HasChange("kustomizations") {
+				properties.Properties.Kustomizations = expandKubernetesFluxConfigurationKustomizations(model.Kustomizations)
+			}
+
+			if metadata.ResourceData.HasChange("continuous_reconciliation_enabled") {
+				properties.Properties.Suspend = utils.Bool(!model.ContinuousReconciliationEnabled)
+			}
+
+			// the protected settings aren't returned by the API so the source must always be re-sent
+			if err := expandKubernetesFluxConfigurationSource(model, properties.Properties); err != nil {
+				return err
+			}
+
+			// these are read-only and rejected by the API when sent
+			properties.Properties.ComplianceState = nil
+			properties.Properties.ErrorMessage = nil
+			properties.Properties.ProvisioningState = nil
+			properties.Properties.RepositoryPublicKey = nil
+			properties.Properties.SourceSyncedCommitId = nil
+			properties.Properties.SourceUpdatedAt = nil
+			properties.Properties.StatusUpdatedAt = nil
+			properties.Properties.Statuses = nil
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the credentials held in the protected settings aren't returned by the API, so these are retained from the state
+			var state KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := KubernetesFluxConfigurationModel{
+				Name:      id.FluxConfigurationName,
+				ClusterId: parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID(),
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					model.Bucket = flattenKubernetesFluxConfigurationBucket(props.Bucket, state.Bucket)
+					model.GitRepository = flattenKubernetesFluxConfigurationGitRepository(props.GitRepository, state.GitRepository)
+					model.Kustomizations = flattenKubernetesFluxConfigurationKustomizations(props.Kustomizations)
+
+					if props.Namespace != nil {
+						model.Namespace = *props.Namespace
+					}
+
+					if props.Scope != nil {
+						model.Scope = string(*props.Scope)
+					}
+
+					model.ContinuousReconciliationEnabled = true
+					if props.Suspend != nil {
+						model.ContinuousReconciliationEnabled = !*props.Suspend
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, fluxconfiguration.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFluxConfigurationSource(model KubernetesFluxConfigurationModel, props *fluxconfiguration.FluxConfigurationProperties) error {
+	protectedSettings := make(map[string]string)
+
+	props.Bucket = nil
+	props.GitRepository = nil
+
+	if len(model.GitRepository) > 0 {
+		gitRepository, err := expandKubernetesFluxConfigurationGitRepository(model.GitRepository[0])
+		if err != nil {
+			return err
+		}
+
+		props.GitRepository = gitRepository
+		props.SourceKind = utils.ToPtr(fluxconfiguration.SourceKindTypeGitRepository)
+
+		if v := model.GitRepository[0].HttpsKeyBase64; v != "" {
+			protectedSettings[fluxConfigurationProtectedSettingHTTPSKey] = v
+		}
+		if v := model.GitRepository[0].SshPrivateKeyBase64; v != "" {
+			protectedSettings[fluxConfigurationProtectedSettingSSHPrivateKey] = v
+		}
+	}
+
+	if len(model.Bucket) > 0 {
+		props.Bucket = expandKubernetesFluxConfigurationBucket(model.Bucket[0])
+		props.SourceKind = utils.ToPtr(fluxconfiguration.SourceKindTypeBucket)
+
+		if v := model.Bucket[0].SecretKeyBase64; v != "" {
+			protectedSettings[fluxConfigurationProtectedSettingBucketSecretKey] = v
+		}
+	}
+
+	props.ConfigurationProtectedSettings = &protectedSettings
+	return nil
+}
+
+func expandKubernetesFluxConfigurationGitRepository(input KubernetesFluxConfigurationGitRepository) (*fluxconfiguration.GitRepositoryDefinition, error) {
+	output := &fluxconfiguration.GitRepositoryDefinition{
+		RepositoryRef:         &fluxconfiguration.RepositoryRefDefinition{},
+		SyncIntervalInSeconds: utils.Int64(input.SyncIntervalInSeconds),
+		TimeoutInSeconds:      utils.Int64(input.TimeoutInSeconds),
+		Url:                   utils.String(input.Url),
+	}
+
+	switch input.ReferenceType {
+	case fluxConfigurationReferenceTypeBranch:
+		output.RepositoryRef.Branch = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeCommit:
+		output.RepositoryRef.Commit = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeSemver:
+		output.RepositoryRef.Semver = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeTag:
+		output.RepositoryRef.Tag = utils.String(input.ReferenceValue)
+	default:
+		return nil, fmt.Errorf("unsupported `reference_type` %q", input.ReferenceType)
+	}
+
+	if input.HttpsCACertBase64 != "" {
+		output.HTTPSCACert = utils.String(input.HttpsCACertBase64)
+	}
+
+	if input.HttpsUser != "" {
+		output.HTTPSUser = utils.String(input.HttpsUser)
+	}
+
+	if input.SshKnownHostsBase64 != "" {
+		output.SshKnownHosts = utils.String(input.SshKnownHostsBase64)
+	}
+
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+
+	return output, nil
+}
+
+func expandKubernetesFluxConfigurationBucket(input KubernetesFluxConfigurationBucket) *fluxconfiguration.BucketDefinition {
+	output := &fluxconfiguration.BucketDefinition{
+		BucketName:            utils.String(input.BucketName),
+		Insecure:              utils.Bool(!input.TlsEnabled),
+		SyncIntervalInSeconds: utils.Int64(input.SyncIntervalInSeconds),
+		TimeoutInSeconds:      utils.Int64(input.TimeoutInSeconds),
+		Url:                   utils.String(input.Url),
+	}
+
+	if input.AccessKey != "" {
+		output.AccessKey = utils.String(input.AccessKey)
+	}
+
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+
+	return output
+}
+
+func expandKubernetesFluxConfigurationKustomizations(input []KubernetesFluxConfigurationKustomization) *map[string]fluxconfiguration.KustomizationDefinition {
+	output := make(map[string]fluxconfiguration.KustomizationDefinition)
+	for _, v := range input {
+		dependsOn := v.DependsOn
+		if dependsOn == nil {
+			dependsOn = []string{}
+		}
+
+		output[v.Name] = fluxconfiguration.KustomizationDefinition{
+			DependsOn:              &dependsOn,
+			Force:                  utils.Bool(v.Force),
+			Name:                   utils.String(v.Name),
+			Path:                   utils.String(v.Path),
+			Prune:                  utils.Bool(v.Prune),
+			RetryIntervalInSeconds: utils.Int64(v.RetryIntervalInSeconds),
+			SyncIntervalInSeconds:  utils.Int64(v.SyncIntervalInSeconds),
+			TimeoutInSeconds:       utils.Int64(v.TimeoutInSeconds),
+		}
+	}
+
+	return &output
+}
+
+func flattenKubernetesFluxConfigurationGitRepository(input *fluxconfiguration.GitRepositoryDefinition, state []KubernetesFluxConfigurationGitRepository) []KubernetesFluxConfigurationGitRepository {
+	if input == nil {
+		return []KubernetesFluxConfigurationGitRepository{}
+	}
+
+	output := KubernetesFluxConfigurationGitRepository{}
+
+	if len(state) > 0 {
+		output.HttpsKeyBase64 = state[0].HttpsKeyBase64
+		output.SshPrivateKeyBase64 = state[0].SshPrivateKeyBase64
+	}
+
+	if input.HTTPSCACert != nil {
+		output.HttpsCACertBase64 = *input.HTTPSCACert
+	}
+
+	if input.HTTPSUser != nil {
+		output.HttpsUser = *input.HTTPSUser
+	}
+
+	if input.LocalAuthRef != nil {
+		output.LocalAuthReference = *input.LocalAuthRef
+	}
+
+	if ref := input.RepositoryRef; ref != nil {
+		switch {
+		case ref.Branch != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeBranch
+			output.ReferenceValue = *ref.Branch
+		case ref.Commit != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeCommit
+			output.ReferenceValue = *ref.Commit
+		case ref.Semver != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeSemver
+			output.ReferenceValue = *ref.Semver
+		case ref.Tag != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeTag
+			output.ReferenceValue = *ref.Tag
+		}
+	}
+
+	if input.SshKnownHosts != nil {
+		output.SshKnownHostsBase64 = *input.SshKnownHosts
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = *input.SyncIntervalInSeconds
+	}
+
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = *input.TimeoutInSeconds
+	}
+
+	if input.Url != nil {
+		output.Url = *input.Url
+	}
+
+	return []KubernetesFluxConfigurationGitRepository{output}
+}
+
+func flattenKubernetesFluxConfigurationBucket(input *fluxconfiguration.BucketDefinition, state []KubernetesFluxConfigurationBucket) []KubernetesFluxConfigurationBucket {
+	if input == nil {
+		return []KubernetesFluxConfigurationBucket{}
+	}
+
+	output := KubernetesFluxConfigurationBucket{
+		TlsEnabled: true,
+	}
+
+	if len(state) > 0 {
+		output.SecretKeyBase64 = state[0].SecretKeyBase64
+	}
+
+	if input.AccessKey != nil {
+		output.AccessKey = *input.AccessKey
+	}
+
+	if input.BucketName != nil {
+		output.BucketName = *input.BucketName
+	}
+
+	if input.Insecure != nil {
+		output.TlsEnabled = !*input.Insecure
+	}
+
+	if input.LocalAuthRef != nil {
+		output.LocalAuthReference = *input.LocalAuthRef
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = *input.SyncIntervalInSeconds
+	}
+
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = *input.TimeoutInSeconds
+	}
+
+	if input.Url != nil {
+		output.Url = *input.Url
+	}
+
+	return []KubernetesFluxConfigurationBucket{output}
+}
+
+func flattenKubernetesFluxConfigurationKustomizations(input *map[string]fluxconfiguration.KustomizationDefinition) []KubernetesFluxConfigurationKustomization {
+	output := make([]KubernetesFluxConfigurationKustomization, 0)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		kustomization := KubernetesFluxConfigurationKustomization{
+			Name: k,
+		}
+
+		if v.DependsOn != nil {
+			kustomization.DependsOn = *v.DependsOn
+		}
+
+		if v.Force != nil {
+			kustomization.Force = *v.Force
+		}
+
+		if v.Path != nil {
+			kustomization.Path = *v.Path
+		}
+
+		if v.Prune != nil {
+			kustomization.Prune = *v.Prune
+		}
+
+		if v.RetryIntervalInSeconds != nil {
+			kustomization.RetryIntervalInSeconds = *v.RetryIntervalInSeconds
+		}
+
+		if v.SyncIntervalInSeconds != nil {
+			kustomization.SyncIntervalInSeconds = *v.SyncIntervalInSeconds
+		}
+
+		if v.TimeoutInSeconds != nil {
+			kustomization.TimeoutInSeconds = *v.TimeoutInSeconds
+		}
+
+		output = append(output, kustomization)
+	}
+
+	return output
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -52,7 +53,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					"vm_size": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ForceNew:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
 
@@ -188,7 +188,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					"os_sku": {
 						Type:     pluginsdk.TypeString,
 						Optional: true,
-						ForceNew: true,
 						Computed: true, // defaults to Ubuntu if using Linux
 						ValidateFunc: validation.StringInSlice([]string{
 							string(containerservice.OSSKUUbuntu),
@@ -233,6 +232,13 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 						ForceNew: true,
 					},
 
+					"snapshot_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.SnapshotID,
+					},
+
 					"scale_down_mode": {
 						Type:     pluginsdk.TypeString,
 						Optional: true,
@@ -251,6 +257,12 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 						ValidateFunc: computeValidate.HostGroupID,
 					},
 
+					"temporary_name_for_rotation": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.KubernetesAgentPoolName,
+					},
+
 					"upgrade_settings": upgradeSettingsSchema(),
 
 					"workload_runtime": {
@@ -631,6 +643,8 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			LinuxOSConfig:             defaultCluster.LinuxOSConfig,
 			MaxPods:                   defaultCluster.MaxPods,
 			OsType:                    defaultCluster.OsType,
+			OsSKU:                     defaultCluster.OsSKU,
+			CreationData:              defaultCluster.CreationData,
 			MaxCount:                  defaultCluster.MaxCount,
 			MessageOfTheDay:           defaultCluster.MessageOfTheDay,
 			MinCount:                  defaultCluster.MinCount,
@@ -740,6 +754,12 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.Manag
 		profile.OsSKU = containerservice.OSSKU(osSku)
 	}
 
+	if snapshotId := raw["snapshot_id"].(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	if podSubnetID := raw["pod_subnet_id"].(string); podSubnetID != "" {
 		profile.PodSubnetID = utils.String(podSubnetID)
 	}
@@ -1155,6 +1175,15 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 		workloadRunTime = string(agentPool.WorkloadRuntime)
 	}
 
+	snapshotId := ""
+	if agentPool.CreationData != nil && agentPool.CreationData.SourceResourceID != nil {
+		id, err := parse.SnapshotID(*agentPool.CreationData.SourceResourceID)
+		if err != nil {
+			return nil, err
+		}
+		snapshotId = id.ID()
+	}
+
 	upgradeSettings := flattenUpgradeSettings(agentPool.UpgradeSettings)
 	linuxOSConfig, err := flattenAgentPoolLinuxOSConfig(agentPool.LinuxOSConfig)
 	if err != nil {
@@ -1181,7 +1210,9 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 		"os_disk_type":                  string(osDiskType),
 		"os_sku":                        string(agentPool.OsSKU),
 		"scale_down_mode":               string(scaleDownMode),
+		"snapshot_id":                   snapshotId,
 		"tags":                          tags.Flatten(agentPool.Tags),
+		"temporary_name_for_rotation":   d.Get("default_node_pool.0.temporary_name_for_rotation").(string),
 		"type":                          string(agentPool.Type),
 		"ultra_ssd_enabled":             enableUltraSSD,
 		"vm_size":                       vmSize,
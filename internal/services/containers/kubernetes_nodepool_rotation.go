@@ -0,0 +1,125 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// nodePoolRotationProperties are the properties of a Node Pool which can't be updated in-place, but which can be
+// changed by cycling the Node Pool when a `temporary_name_for_rotation` has been specified
+var nodePoolRotationProperties = []string{
+	"vm_size",
+	"os_sku",
+}
+
+// forceNewNodePoolIfNotRotating flags changes to the properties of the Node Pool found at `prefix` which can't be
+// updated in-place as requiring a new resource - unless a `temporary_name_for_rotation` has been specified, in
+// which case the Node Pool is cycled during the update instead
+func forceNewNodePoolIfNotRotating(prefix string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if temporaryName, ok := d.Get(prefix + "temporary_name_for_rotation").(string); ok && temporaryName != "" {
+			return nil
+		}
+
+		for _, property := range nodePoolRotationProperties {
+			key := prefix + property
+			if d.HasChange(key) {
+				if err := d.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// rotateNodePool replaces the Node Pool `id` with one using `parameters` whilst keeping capacity available to the
+// cluster: a temporary Node Pool named `temporaryName` is provisioned using the new configuration, the existing Node
+// Pool is then deleted (which cordons and drains its nodes via the AKS API) and recreated, before the temporary
+// Node Pool is removed in the same manner.
+func rotateNodePool(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.NodePoolId, temporaryName string, parameters containerservice.AgentPool) error {
+	if temporaryName == id.AgentPoolName {
+		return fmt.Errorf("`temporary_name_for_rotation` must be different to the name of the Node Pool (%q)", id.AgentPoolName)
+	}
+	if parameters.ManagedClusterAgentPoolProfileProperties == nil {
+		return fmt.Errorf("rotating %s: `properties` was nil", id)
+	}
+	if poolType := parameters.ManagedClusterAgentPoolProfileProperties.Type; poolType != "" && poolType != containerservice.AgentPoolTypeVirtualMachineScaleSets {
+		return fmt.Errorf("rotating %s: Node Pools can only be rotated when using a `type` of %q", id, string(containerservice.AgentPoolTypeVirtualMachineScaleSets))
+	}
+
+	temporaryId := parse.NewNodePoolID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, temporaryName)
+
+	existing, err := client.Get(ctx, temporaryId.ResourceGroup, temporaryId.ManagedClusterName, temporaryId.AgentPoolName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing temporary %s: %+v", temporaryId, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return fmt.Errorf("the temporary %s used for rotation already exists - either remove it or specify a different `temporary_name_for_rotation`", temporaryId)
+	}
+
+	temporaryProps := *parameters.ManagedClusterAgentPoolProfileProperties
+	temporaryParameters := containerservice.AgentPool{
+		Name:                                     utils.String(temporaryName),
+		ManagedClusterAgentPoolProfileProperties: &temporaryProps,
+	}
+
+	log.Printf("[DEBUG] Creating temporary %s to rotate %s into..", temporaryId, id)
+	if err := createOrUpdateNodePool(ctx, client, temporaryId, temporaryParameters); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %s..", id)
+	if err := deleteNodePoolForRotation(ctx, client, id); err != nil {
+		return fmt.Errorf("%+v - the temporary %s has been left in place", err, temporaryId)
+	}
+
+	log.Printf("[DEBUG] Recreating %s..", id)
+	parameters.Name = utils.String(id.AgentPoolName)
+	if err := createOrUpdateNodePool(ctx, client, id, parameters); err != nil {
+		return fmt.Errorf("%+v - the temporary %s has been left in place", err, temporaryId)
+	}
+
+	log.Printf("[DEBUG] Deleting temporary %s..", temporaryId)
+	if err := deleteNodePoolForRotation(ctx, client, temporaryId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createOrUpdateNodePool(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.NodePoolId, parameters containerservice.AgentPool) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func deleteNodePoolForRotation(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.NodePoolId) error {
+	// Pod Disruption Budgets are intentionally honoured here so that workloads are drained gracefully onto the other Node Pool
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, nil)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
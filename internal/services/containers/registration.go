@@ -56,5 +56,8 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		ContainerConnectedRegistryResource{},
+		KubernetesClusterExtensionResource{},
+		KubernetesClusterSnapshotResource{},
+		KubernetesFluxConfigurationResource{},
 	}
 }
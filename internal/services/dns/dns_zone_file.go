@@ -0,0 +1,400 @@
+package dns
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	privateRecordSets "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// recordTypeFromResourceType returns the record type from the resource type of a record set,
+// e.g. `Microsoft.Network/dnszones/A`
+func recordTypeFromResourceType(input *string) string {
+	if input == nil {
+		return ""
+	}
+	segments := strings.Split(*input, "/")
+	return strings.ToUpper(segments[len(segments)-1])
+}
+
+// isDnsZoneManagedRecordSet returns whether the specified Record Set is managed by Azure
+// rather than by the zone file - the SOA record and the name servers at the apex of the zone
+func isDnsZoneManagedRecordSet(input zonefile.RecordSet) bool {
+	if input.Name != "@" {
+		return false
+	}
+	return input.Type == string(recordsets.RecordTypeSOA) || input.Type == string(recordsets.RecordTypeNS)
+}
+
+// flattenDnsRecordSetToZoneFile converts a DNS Record Set into its zone file representation,
+// returning false when the Record Set can't be represented in a zone file (e.g. alias records)
+func flattenDnsRecordSetToZoneFile(input recordsets.RecordSet) (*zonefile.RecordSet, bool) {
+	props := input.Properties
+	if input.Name == nil || props == nil {
+		return nil, false
+	}
+	if props.TargetResource != nil && props.TargetResource.Id != nil && *props.TargetResource.Id != "" {
+		return nil, false
+	}
+
+	output := zonefile.RecordSet{
+		Name:    *input.Name,
+		Type:    recordTypeFromResourceType(input.Type),
+		Records: make([][]string, 0),
+	}
+	if props.TTL != nil {
+		output.TTL = *props.TTL
+	}
+
+	switch recordsets.RecordType(output.Type) {
+	case recordsets.RecordTypeA:
+		if props.ARecords != nil {
+			for _, v := range *props.ARecords {
+				output.Records = append(output.Records, []string{utils.NormalizeNilableString(v.IPv4Address)})
+			}
+		}
+	case recordsets.RecordTypeAAAA:
+		if props.AAAARecords != nil {
+			for _, v := range *props.AAAARecords {
+				output.Records = append(output.Records, []string{utils.NormalizeNilableString(v.IPv6Address)})
+			}
+		}
+	case recordsets.RecordTypeCAA:
+		if props.CaaRecords != nil {
+			for _, v := range *props.CaaRecords {
+				output.Records = append(output.Records, []string{formatNilableInt64(v.Flags), utils.NormalizeNilableString(v.Tag), utils.NormalizeNilableString(v.Value)})
+			}
+		}
+	case recordsets.RecordTypeCNAME:
+		if props.CNAMERecord != nil && props.CNAMERecord.Cname != nil {
+			output.Records = append(output.Records, []string{zonefile.Fqdn(*props.CNAMERecord.Cname)})
+		}
+	case recordsets.RecordTypeMX:
+		if props.MXRecords != nil {
+			for _, v := range *props.MXRecords {
+				output.Records = append(output.Records, []string{formatNilableInt64(v.Preference), zonefile.Fqdn(utils.NormalizeNilableString(v.Exchange))})
+			}
+		}
+	case recordsets.RecordTypeNS:
+		if props.NSRecords != nil {
+			for _, v := range *props.NSRecords {
+				output.Records = append(output.Records, []string{zonefile.Fqdn(utils.NormalizeNilableString(v.Nsdname))})
+			}
+		}
+	case recordsets.RecordTypePTR:
+		if props.PTRRecords != nil {
+			for _, v := range *props.PTRRecords {
+				output.Records = append(output.Records, []string{zonefile.Fqdn(utils.NormalizeNilableString(v.Ptrdname))})
+			}
+		}
+	case recordsets.RecordTypeSOA:
+		if v := props.SOARecord; v != nil {
+			output.Records = append(output.Records, []string{
+				zonefile.Fqdn(utils.NormalizeNilableString(v.Host)),
+				zonefile.Fqdn(utils.NormalizeNilableString(v.Email)),
+				formatNilableInt64(v.SerialNumber),
+				formatNilableInt64(v.RefreshTime),
+				formatNilableInt64(v.RetryTime),
+				formatNilableInt64(v.ExpireTime),
+				formatNilableInt64(v.MinimumTTL),
+			})
+		}
+	case recordsets.RecordTypeSRV:
+		if props.SRVRecords != nil {
+			for _, v := range *props.SRVRecords {
+				output.Records = append(output.Records, []string{formatNilableInt64(v.Priority), formatNilableInt64(v.Weight), formatNilableInt64(v.Port), zonefile.Fqdn(utils.NormalizeNilableString(v.Target))})
+			}
+		}
+	case recordsets.RecordTypeTXT:
+		if props.TXTRecords != nil {
+			for _, v := range *props.TXTRecords {
+				if v.Value != nil {
+					output.Records = append(output.Records, *v.Value)
+				}
+			}
+		}
+	default:
+		return nil, false
+	}
+
+	return &output, true
+}
+
+// expandDnsRecordSetFromZoneFile converts the zone file representation of a Record Set into the
+// properties of a DNS Record Set
+func expandDnsRecordSetFromZoneFile(input zonefile.RecordSet) (*recordsets.RecordSetProperties, error) {
+	ttl := input.TTL
+	output := recordsets.RecordSetProperties{
+		TTL: &ttl,
+	}
+
+	switch recordsets.RecordType(input.Type) {
+	case recordsets.RecordTypeA:
+		records := make([]recordsets.ARecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.ARecord{IPv4Address: utils.String(v[0])})
+		}
+		output.ARecords = &records
+
+	case recordsets.RecordTypeAAAA:
+		records := make([]recordsets.AaaaRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.AaaaRecord{IPv6Address: utils.String(v[0])})
+		}
+		output.AAAARecords = &records
+
+	case recordsets.RecordTypeCAA:
+		records := make([]recordsets.CaaRecord, 0)
+		for _, v := range input.Records {
+			flags, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing flags %q: %+v", v[0], err)
+			}
+			records = append(records, recordsets.CaaRecord{
+				Flags: utils.Int64(flags),
+				Tag:   utils.String(v[1]),
+				Value: utils.String(v[2]),
+			})
+		}
+		output.CaaRecords = &records
+
+	case recordsets.RecordTypeCNAME:
+		output.CNAMERecord = &recordsets.CnameRecord{
+			Cname: utils.String(strings.TrimSuffix(input.Records[0][0], ".")),
+		}
+
+	case recordsets.RecordTypeMX:
+		records := make([]recordsets.MxRecord, 0)
+		for _, v := range input.Records {
+			preference, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing preference %q: %+v", v[0], err)
+			}
+			records = append(records, recordsets.MxRecord{
+				Preference: utils.Int64(preference),
+				Exchange:   utils.String(strings.TrimSuffix(v[1], ".")),
+			})
+		}
+		output.MXRecords = &records
+
+	case recordsets.RecordTypeNS:
+		records := make([]recordsets.NsRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.NsRecord{Nsdname: utils.String(strings.TrimSuffix(v[0], "."))})
+		}
+		output.NSRecords = &records
+
+	case recordsets.RecordTypePTR:
+		records := make([]recordsets.PtrRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.PtrRecord{Ptrdname: utils.String(strings.TrimSuffix(v[0], "."))})
+		}
+		output.PTRRecords = &records
+
+	case recordsets.RecordTypeSRV:
+		records := make([]recordsets.SrvRecord, 0)
+		for _, v := range input.Records {
+			values := make([]int64, 3)
+			for i := range values {
+				value, err := strconv.ParseInt(v[i], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("parsing %q: %+v", v[i], err)
+				}
+				values[i] = value
+			}
+			records = append(records, recordsets.SrvRecord{
+				Priority: utils.Int64(values[0]),
+				Weight:   utils.Int64(values[1]),
+				Port:     utils.Int64(values[2]),
+				Target:   utils.String(strings.TrimSuffix(v[3], ".")),
+			})
+		}
+		output.SRVRecords = &records
+
+	case recordsets.RecordTypeTXT:
+		records := make([]recordsets.TxtRecord, 0)
+		for _, v := range input.Records {
+			value := v
+			records = append(records, recordsets.TxtRecord{Value: &value})
+		}
+		output.TXTRecords = &records
+
+	default:
+		return nil, fmt.Errorf("the record type %q can't be managed from a zone file", input.Type)
+	}
+
+	return &output, nil
+}
+
+// flattenPrivateDnsRecordSetToZoneFile converts a Private DNS Record Set into its zone file representation
+func flattenPrivateDnsRecordSetToZoneFile(input privateRecordSets.RecordSet) (*zonefile.RecordSet, bool) {
+	props := input.Properties
+	if input.Name == nil || props == nil {
+		return nil, false
+	}
+
+	output := zonefile.RecordSet{
+		Name:    *input.Name,
+		Type:    recordTypeFromResourceType(input.Type),
+		Records: make([][]string, 0),
+	}
+	if props.Ttl != nil {
+		output.TTL = *props.Ttl
+	}
+
+	switch privateRecordSets.RecordType(output.Type) {
+	case privateRecordSets.RecordTypeA:
+		if props.ARecords != nil {
+			for _, v := range *props.ARecords {
+				output.Records = append(output.Records, []string{utils.NormalizeNilableString(v.IPv4Address)})
+			}
+		}
+	case privateRecordSets.RecordTypeAAAA:
+		if props.AaaaRecords != nil {
+			for _, v := range *props.AaaaRecords {
+				output.Records = append(output.Records, []string{utils.NormalizeNilableString(v.IPv6Address)})
+			}
+		}
+	case privateRecordSets.RecordTypeCNAME:
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			output.Records = append(output.Records, []string{zonefile.Fqdn(*props.CnameRecord.Cname)})
+		}
+	case privateRecordSets.RecordTypeMX:
+		if props.MxRecords != nil {
+			for _, v := range *props.MxRecords {
+				output.Records = append(output.Records, []string{formatNilableInt64(v.Preference), zonefile.Fqdn(utils.NormalizeNilableString(v.Exchange))})
+			}
+		}
+	case privateRecordSets.RecordTypePTR:
+		if props.PtrRecords != nil {
+			for _, v := range *props.PtrRecords {
+				output.Records = append(output.Records, []string{zonefile.Fqdn(utils.NormalizeNilableString(v.Ptrdname))})
+			}
+		}
+	case privateRecordSets.RecordTypeSOA:
+		if v := props.SoaRecord; v != nil {
+			output.Records = append(output.Records, []string{
+				zonefile.Fqdn(utils.NormalizeNilableString(v.Host)),
+				zonefile.Fqdn(utils.NormalizeNilableString(v.Email)),
+				formatNilableInt64(v.SerialNumber),
+				formatNilableInt64(v.RefreshTime),
+				formatNilableInt64(v.RetryTime),
+				formatNilableInt64(v.ExpireTime),
+				formatNilableInt64(v.MinimumTtl),
+			})
+		}
+	case privateRecordSets.RecordTypeSRV:
+		if props.SrvRecords != nil {
+			for _, v := range *props.SrvRecords {
+				output.Records = append(output.Records, []string{formatNilableInt64(v.Priority), formatNilableInt64(v.Weight), formatNilableInt64(v.Port), zonefile.Fqdn(utils.NormalizeNilableString(v.Target))})
+			}
+		}
+	case privateRecordSets.RecordTypeTXT:
+		if props.TxtRecords != nil {
+			for _, v := range *props.TxtRecords {
+				if v.Value != nil {
+					output.Records = append(output.Records, *v.Value)
+				}
+			}
+		}
+	default:
+		return nil, false
+	}
+
+	return &output, true
+}
+
+// expandPrivateDnsRecordSetFromZoneFile converts the zone file representation of a Record Set into the
+// properties of a Private DNS Record Set
+func expandPrivateDnsRecordSetFromZoneFile(input zonefile.RecordSet) (*privateRecordSets.RecordSetProperties, error) {
+	ttl := input.TTL
+	output := privateRecordSets.RecordSetProperties{
+		Ttl: &ttl,
+	}
+
+	switch privateRecordSets.RecordType(input.Type) {
+	case privateRecordSets.RecordTypeA:
+		records := make([]privateRecordSets.ARecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privateRecordSets.ARecord{IPv4Address: utils.String(v[0])})
+		}
+		output.ARecords = &records
+
+	case privateRecordSets.RecordTypeAAAA:
+		records := make([]privateRecordSets.AaaaRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privateRecordSets.AaaaRecord{IPv6Address: utils.String(v[0])})
+		}
+		output.AaaaRecords = &records
+
+	case privateRecordSets.RecordTypeCNAME:
+		output.CnameRecord = &privateRecordSets.CnameRecord{
+			Cname: utils.String(strings.TrimSuffix(input.Records[0][0], ".")),
+		}
+
+	case privateRecordSets.RecordTypeMX:
+		records := make([]privateRecordSets.MxRecord, 0)
+		for _, v := range input.Records {
+			preference, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing preference %q: %+v", v[0], err)
+			}
+			records = append(records, privateRecordSets.MxRecord{
+				Preference: utils.Int64(preference),
+				Exchange:   utils.String(strings.TrimSuffix(v[1], ".")),
+			})
+		}
+		output.MxRecords = &records
+
+	case privateRecordSets.RecordTypePTR:
+		records := make([]privateRecordSets.PtrRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privateRecordSets.PtrRecord{Ptrdname: utils.String(strings.TrimSuffix(v[0], "."))})
+		}
+		output.PtrRecords = &records
+
+	case privateRecordSets.RecordTypeSRV:
+		records := make([]privateRecordSets.SrvRecord, 0)
+		for _, v := range input.Records {
+			values := make([]int64, 3)
+			for i := range values {
+				value, err := strconv.ParseInt(v[i], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("parsing %q: %+v", v[i], err)
+				}
+				values[i] = value
+			}
+			records = append(records, privateRecordSets.SrvRecord{
+				Priority: utils.Int64(values[0]),
+				Weight:   utils.Int64(values[1]),
+				Port:     utils.Int64(values[2]),
+				Target:   utils.String(strings.TrimSuffix(v[3], ".")),
+			})
+		}
+		output.SrvRecords = &records
+
+	case privateRecordSets.RecordTypeTXT:
+		records := make([]privateRecordSets.TxtRecord, 0)
+		for _, v := range input.Records {
+			value := v
+			records = append(records, privateRecordSets.TxtRecord{Value: &value})
+		}
+		output.TxtRecords = &records
+
+	default:
+		return nil, fmt.Errorf("the record type %q can't be managed within a Private DNS Zone", input.Type)
+	}
+
+	return &output, nil
+}
+
+func formatNilableInt64(input *int64) string {
+	if input == nil {
+		return "0"
+	}
+	return strconv.FormatInt(*input, 10)
+}
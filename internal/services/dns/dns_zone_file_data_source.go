@@ -0,0 +1,118 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
+	privateRecordSets "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceDnsZoneFile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsZoneFileRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+				ExactlyOneOf: []string{"dns_zone_id", "private_dns_zone_id"},
+			},
+
+			"private_dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+				ExactlyOneOf: []string{"dns_zone_id", "private_dns_zone_id"},
+			},
+
+			"zone_file": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsZoneFileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if v := d.Get("dns_zone_id").(string); v != "" {
+		client := meta.(*clients.Client).Dns.RecordSets
+
+		zoneId, err := zones.ParseDnsZoneID(v)
+		if err != nil {
+			return err
+		}
+
+		zone, err := meta.(*clients.Client).Dns.Zones.Get(ctx, *zoneId)
+		if err != nil {
+			if response.WasNotFound(zone.HttpResponse) {
+				return fmt.Errorf("%s was not found", *zoneId)
+			}
+			return fmt.Errorf("retrieving %s: %+v", *zoneId, err)
+		}
+
+		id := recordsets.NewDnsZoneID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.ZoneName)
+		resp, err := client.ListAllByDnsZoneComplete(ctx, id, recordsets.DefaultListAllByDnsZoneOperationOptions())
+		if err != nil {
+			return fmt.Errorf("listing Record Sets for %s: %+v", *zoneId, err)
+		}
+
+		recordSets := make([]zonefile.RecordSet, 0)
+		for _, item := range resp.Items {
+			if recordSet, ok := flattenDnsRecordSetToZoneFile(item); ok {
+				recordSets = append(recordSets, *recordSet)
+			}
+		}
+
+		d.SetId(zoneId.ID())
+		d.Set("zone_file", zonefile.Render(zoneId.ZoneName, recordSets))
+		return nil
+	}
+
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+
+	zoneId, err := privatezones.ParsePrivateDnsZoneID(d.Get("private_dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	zone, err := meta.(*clients.Client).PrivateDns.PrivateZonesClient.Get(ctx, *zoneId)
+	if err != nil {
+		if response.WasNotFound(zone.HttpResponse) {
+			return fmt.Errorf("%s was not found", *zoneId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *zoneId, err)
+	}
+
+	id := privateRecordSets.NewPrivateDnsZoneID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateZoneName)
+	resp, err := client.ListComplete(ctx, id, privateRecordSets.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Record Sets for %s: %+v", *zoneId, err)
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	for _, item := range resp.Items {
+		if recordSet, ok := flattenPrivateDnsRecordSetToZoneFile(item); ok {
+			recordSets = append(recordSets, *recordSet)
+		}
+	}
+
+	d.SetId(zoneId.ID())
+	d.Set("zone_file", zonefile.Render(zoneId.PrivateZoneName, recordSets))
+	return nil
+}
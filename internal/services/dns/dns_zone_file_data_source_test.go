@@ -0,0 +1,106 @@
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsZoneFileDataSource struct{}
+
+func TestAccDnsZoneFileDataSource_dnsZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zone_file", "test")
+	r := DnsZoneFileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.dnsZone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^@\t\d+\tIN\tSOA\t`)),
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^www\t300\tIN\tA\t10\.0\.0\.1$`)),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneFileDataSource_privateDnsZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zone_file", "test")
+	r := DnsZoneFileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.privateDnsZone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^@\t\d+\tIN\tSOA\t`)),
+				check.That(data.ResourceName).Key("zone_file").MatchesRegex(regexp.MustCompile(`(?m)^www\t300\tIN\tA\t10\.0\.0\.1$`)),
+			),
+		},
+	})
+}
+
+func (DnsZoneFileDataSource) dnsZone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "www"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["10.0.0.1"]
+}
+
+data "azurerm_dns_zone_file" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+
+  depends_on = [azurerm_dns_a_record.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (DnsZoneFileDataSource) privateDnsZone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_a_record" "test" {
+  name                = "www"
+  zone_name           = azurerm_private_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["10.0.0.1"]
+}
+
+data "azurerm_dns_zone_file" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+
+  depends_on = [azurerm_private_dns_a_record.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
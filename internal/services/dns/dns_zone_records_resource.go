@@ -0,0 +1,503 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
+	privateRecordSets "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDnsZoneRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneRecordsCreateUpdate,
+		Read:   resourceDnsZoneRecordsRead,
+		Update: resourceDnsZoneRecordsCreateUpdate,
+		Delete: resourceDnsZoneRecordsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := privatezones.ParsePrivateDnsZoneID(id); err == nil {
+				return nil
+			}
+			_, err := zones.ParseDnsZoneID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+				ExactlyOneOf: []string{"dns_zone_id", "private_dns_zone_id"},
+			},
+
+			"private_dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+				ExactlyOneOf: []string{"dns_zone_id", "private_dns_zone_id"},
+			},
+
+			"zone_file": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: dnsZoneFileDiffSuppress,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// the zone file can only be validated once the DNS Zone ID (and so the origin) is known
+			zone, err := newDnsZoneRecordsZone(v.(*clients.Client), diff.Get("dns_zone_id").(string), diff.Get("private_dns_zone_id").(string))
+			if err != nil {
+				return nil
+			}
+
+			recordSets, err := parseDnsZoneFile(diff.Get("zone_file").(string), zone.ZoneName())
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			for _, recordSet := range recordSets {
+				if err := zone.ValidateRecordSet(recordSet); err != nil {
+					return fmt.Errorf("validating the %s Record Set %q: %+v", recordSet.Type, recordSet.Name, err)
+				}
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceDnsZoneRecordsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zone, err := newDnsZoneRecordsZone(meta.(*clients.Client), d.Get("dns_zone_id").(string), d.Get("private_dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	desired, err := parseDnsZoneFile(d.Get("zone_file").(string), zone.ZoneName())
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	existing, err := zone.ListRecordSets(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Record Sets for %s: %+v", zone, err)
+	}
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, v := range desired {
+		desiredKeys[v.Key()] = struct{}{}
+	}
+
+	// Record Sets are removed first, since a CNAME can't be created alongside any other Record Set with the same name
+	for key, v := range existing {
+		if _, ok := desiredKeys[key]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting the %s Record Set %q from %s since it's not present in the `zone_file`", v.RecordSet.Type, v.RecordSet.Name, zone)
+		if err := zone.DeleteRecordSet(ctx, v.RecordSet); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range desired {
+		current, ok := existing[v.Key()]
+		if ok && zonefile.RecordSetEqual(current.RecordSet, v) {
+			continue
+		}
+
+		// metadata can't be expressed in a zone file, so is retained from the existing Record Set
+		var metadata *map[string]string
+		if ok {
+			metadata = current.Metadata
+		}
+
+		log.Printf("[DEBUG] Creating/Updating the %s Record Set %q within %s..", v.Type, v.Name, zone)
+		if err := zone.CreateOrUpdateRecordSet(ctx, v, metadata); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(zone.ID())
+
+	return resourceDnsZoneRecordsRead(d, meta)
+}
+
+func resourceDnsZoneRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zone, err := parseDnsZoneRecordsZone(meta.(*clients.Client), d.Id())
+	if err != nil {
+		return err
+	}
+
+	exists, err := zone.Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", zone, err)
+	}
+	if !exists {
+		log.Printf("[DEBUG] %s was not found - removing from state", zone)
+		d.SetId("")
+		return nil
+	}
+
+	existing, err := zone.ListRecordSets(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Record Sets for %s: %+v", zone, err)
+	}
+
+	actual := make([]zonefile.RecordSet, 0, len(existing))
+	for _, v := range existing {
+		actual = append(actual, v.RecordSet)
+	}
+
+	if zone.IsPrivate() {
+		d.Set("dns_zone_id", "")
+		d.Set("private_dns_zone_id", zone.ID())
+	} else {
+		d.Set("dns_zone_id", zone.ID())
+		d.Set("private_dns_zone_id", "")
+	}
+
+	// the configured zone file is retained where it describes the same records, so that
+	// formatting and comments in the file don't cause a diff
+	configured, err := parseDnsZoneFile(d.Get("zone_file").(string), zone.ZoneName())
+	if err != nil || !zonefile.Equal(configured, actual) {
+		d.Set("zone_file", zonefile.Render(zone.ZoneName(), actual))
+	}
+
+	return nil
+}
+
+func resourceDnsZoneRecordsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zone, err := parseDnsZoneRecordsZone(meta.(*clients.Client), d.Id())
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := parseDnsZoneFile(d.Get("zone_file").(string), zone.ZoneName())
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	for _, v := range recordSets {
+		if err := zone.DeleteRecordSet(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDnsZoneFile parses the zone file, omitting the Record Sets which are managed by Azure
+func parseDnsZoneFile(input string, zoneName string) ([]zonefile.RecordSet, error) {
+	parsed, err := zonefile.Parse(input, zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]zonefile.RecordSet, 0)
+	for _, v := range parsed {
+		if isDnsZoneManagedRecordSet(v) {
+			continue
+		}
+		output = append(output, v)
+	}
+
+	return output, nil
+}
+
+func dnsZoneFileDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	zoneName := ""
+	if zoneId, err := zones.ParseDnsZoneID(d.Get("dns_zone_id").(string)); err == nil {
+		zoneName = zoneId.ZoneName
+	} else if zoneId, err := privatezones.ParsePrivateDnsZoneID(d.Get("private_dns_zone_id").(string)); err == nil {
+		zoneName = zoneId.PrivateZoneName
+	} else {
+		return false
+	}
+
+	oldRecordSets, err := parseDnsZoneFile(old, zoneName)
+	if err != nil {
+		return false
+	}
+
+	newRecordSets, err := parseDnsZoneFile(new, zoneName)
+	if err != nil {
+		return false
+	}
+
+	return zonefile.Equal(oldRecordSets, newRecordSets)
+}
+
+type dnsZoneFileRecordSet struct {
+	RecordSet zonefile.RecordSet
+	Metadata  *map[string]string
+}
+
+// dnsZoneRecordsZone manages the Record Sets within either a DNS Zone or a Private DNS Zone from a zone file
+type dnsZoneRecordsZone interface {
+	fmt.Stringer
+
+	ID() string
+	ZoneName() string
+	IsPrivate() bool
+	Exists(ctx context.Context) (bool, error)
+
+	// ListRecordSets returns the Record Sets within the zone which can be managed from a zone file, keyed by their
+	// name and type - alias Record Sets and those managed by Azure are omitted
+	ListRecordSets(ctx context.Context) (map[string]dnsZoneFileRecordSet, error)
+	ValidateRecordSet(input zonefile.RecordSet) error
+	CreateOrUpdateRecordSet(ctx context.Context, input zonefile.RecordSet, metadata *map[string]string) error
+	DeleteRecordSet(ctx context.Context, input zonefile.RecordSet) error
+}
+
+// newDnsZoneRecordsZone returns the zone referenced by either `dns_zone_id` or `private_dns_zone_id`
+func newDnsZoneRecordsZone(client *clients.Client, dnsZoneId string, privateDnsZoneId string) (dnsZoneRecordsZone, error) {
+	if privateDnsZoneId != "" {
+		id, err := privatezones.ParsePrivateDnsZoneID(privateDnsZoneId)
+		if err != nil {
+			return nil, err
+		}
+		return privateDnsZoneRecordsZone{client: client, id: *id}, nil
+	}
+
+	id, err := zones.ParseDnsZoneID(dnsZoneId)
+	if err != nil {
+		return nil, err
+	}
+	return publicDnsZoneRecordsZone{client: client, id: *id}, nil
+}
+
+// parseDnsZoneRecordsZone returns the zone for the Resource ID, which is the ID of either a DNS Zone or a Private DNS Zone
+func parseDnsZoneRecordsZone(client *clients.Client, input string) (dnsZoneRecordsZone, error) {
+	if id, err := privatezones.ParsePrivateDnsZoneIDInsensitively(input); err == nil {
+		return privateDnsZoneRecordsZone{client: client, id: *id}, nil
+	}
+
+	id, err := zones.ParseDnsZoneIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+	return publicDnsZoneRecordsZone{client: client, id: *id}, nil
+}
+
+type publicDnsZoneRecordsZone struct {
+	client *clients.Client
+	id     zones.DnsZoneId
+}
+
+func (z publicDnsZoneRecordsZone) String() string {
+	return z.id.String()
+}
+
+func (z publicDnsZoneRecordsZone) ID() string {
+	return z.id.ID()
+}
+
+func (z publicDnsZoneRecordsZone) ZoneName() string {
+	return z.id.ZoneName
+}
+
+func (z publicDnsZoneRecordsZone) IsPrivate() bool {
+	return false
+}
+
+func (z publicDnsZoneRecordsZone) Exists(ctx context.Context) (bool, error) {
+	resp, err := z.client.Dns.Zones.Get(ctx, z.id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (z publicDnsZoneRecordsZone) ListRecordSets(ctx context.Context) (map[string]dnsZoneFileRecordSet, error) {
+	id := recordsets.NewDnsZoneID(z.id.SubscriptionId, z.id.ResourceGroupName, z.id.ZoneName)
+	resp, err := z.client.Dns.RecordSets.ListAllByDnsZoneComplete(ctx, id, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]dnsZoneFileRecordSet)
+	for _, item := range resp.Items {
+		recordSet, ok := flattenDnsRecordSetToZoneFile(item)
+		if !ok || isDnsZoneManagedRecordSet(*recordSet) {
+			continue
+		}
+
+		v := dnsZoneFileRecordSet{
+			RecordSet: *recordSet,
+		}
+		if item.Properties != nil {
+			v.Metadata = item.Properties.Metadata
+		}
+		output[recordSet.Key()] = v
+	}
+
+	return output, nil
+}
+
+func (z publicDnsZoneRecordsZone) ValidateRecordSet(input zonefile.RecordSet) error {
+	_, err := expandDnsRecordSetFromZoneFile(input)
+	return err
+}
+
+func (z publicDnsZoneRecordsZone) CreateOrUpdateRecordSet(ctx context.Context, input zonefile.RecordSet, metadata *map[string]string) error {
+	props, err := expandDnsRecordSetFromZoneFile(input)
+	if err != nil {
+		return fmt.Errorf("expanding the %s Record Set %q: %+v", input.Type, input.Name, err)
+	}
+	props.Metadata = metadata
+
+	id := recordsets.NewRecordTypeID(z.id.SubscriptionId, z.id.ResourceGroupName, z.id.ZoneName, recordsets.RecordType(input.Type), input.Name)
+	payload := recordsets.RecordSet{
+		Name:       utils.String(input.Name),
+		Properties: props,
+	}
+	if _, err := z.client.Dns.RecordSets.CreateOrUpdate(ctx, id, payload, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (z publicDnsZoneRecordsZone) DeleteRecordSet(ctx context.Context, input zonefile.RecordSet) error {
+	id := recordsets.NewRecordTypeID(z.id.SubscriptionId, z.id.ResourceGroupName, z.id.ZoneName, recordsets.RecordType(input.Type), input.Name)
+	if resp, err := z.client.Dns.RecordSets.Delete(ctx, id, recordsets.DefaultDeleteOperationOptions()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+type privateDnsZoneRecordsZone struct {
+	client *clients.Client
+	id     privatezones.PrivateDnsZoneId
+}
+
+func (z privateDnsZoneRecordsZone) String() string {
+	return z.id.String()
+}
+
+func (z privateDnsZoneRecordsZone) ID() string {
+	return z.id.ID()
+}
+
+func (z privateDnsZoneRecordsZone) ZoneName() string {
+	return z.id.PrivateZoneName
+}
+
+func (z privateDnsZoneRecordsZone) IsPrivate() bool {
+	return true
+}
+
+func (z privateDnsZoneRecordsZone) Exists(ctx context.Context) (bool, error) {
+	resp, err := z.client.PrivateDns.PrivateZonesClient.Get(ctx, z.id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (z privateDnsZoneRecordsZone) ListRecordSets(ctx context.Context) (map[string]dnsZoneFileRecordSet, error) {
+	id := privateRecordSets.NewPrivateDnsZoneID(z.id.SubscriptionId, z.id.ResourceGroupName, z.id.PrivateZoneName)
+	resp, err := z.client.PrivateDns.RecordSetsClient.ListComplete(ctx, id, privateRecordSets.DefaultListOperationOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]dnsZoneFileRecordSet)
+	for _, item := range resp.Items {
+		// records registered automatically by a Virtual Network Link are managed by Azure
+		if item.Properties != nil && item.Properties.IsAutoRegistered != nil && *item.Properties.IsAutoRegistered {
+			continue
+		}
+
+		recordSet, ok := flattenPrivateDnsRecordSetToZoneFile(item)
+		if !ok || isDnsZoneManagedRecordSet(*recordSet) {
+			continue
+		}
+
+		v := dnsZoneFileRecordSet{
+			RecordSet: *recordSet,
+		}
+		if item.Properties != nil {
+			v.Metadata = item.Properties.Metadata
+		}
+		output[recordSet.Key()] = v
+	}
+
+	return output, nil
+}
+
+func (z privateDnsZoneRecordsZone) ValidateRecordSet(input zonefile.RecordSet) error {
+	_, err := expandPrivateDnsRecordSetFromZoneFile(input)
+	return err
+}
+
+func (z privateDnsZoneRecordsZone) CreateOrUpdateRecordSet(ctx context.Context, input zonefile.RecordSet, metadata *map[string]string) error {
+	props, err := expandPrivateDnsRecordSetFromZoneFile(input)
+	if err != nil {
+		return fmt.Errorf("expanding the %s Record Set %q: %+v", input.Type, input.Name, err)
+	}
+	props.Metadata = metadata
+
+	id := privateRecordSets.NewRecordTypeID(z.id.SubscriptionId, z.id.ResourceGroupName, z.id.PrivateZoneName, privateRecordSets.RecordType(input.Type), input.Name)
+	payload := privateRecordSets.RecordSet{
+		Name:       utils.String(input.Name),
+		Properties: props,
+	}
+	if _, err := z.client.PrivateDns.RecordSetsClient.CreateOrUpdate(ctx, id, payload, privateRecordSets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (z privateDnsZoneRecordsZone) DeleteRecordSet(ctx context.Context, input zonefile.RecordSet) error {
+	id := privateRecordSets.NewRecordTypeID(z.id.SubscriptionId, z.id.ResourceGroupName, z.id.PrivateZoneName, privateRecordSets.RecordType(input.Type), input.Name)
+	if resp, err := z.client.PrivateDns.RecordSetsClient.Delete(ctx, id, privateRecordSets.DefaultDeleteOperationOptions()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
+	privateRecordSets "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneRecordsResource struct{}
+
+func TestAccDnsZoneRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("zone_file"),
+	})
+}
+
+func TestAccDnsZoneRecords_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_removesUnmanagedRecordSets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withExistingRecord(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccDnsZoneRecords_privateDnsZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateDnsZone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("zone_file"),
+	})
+}
+
+func (DnsZoneRecordsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if privateZoneId, err := privatezones.ParsePrivateDnsZoneID(state.ID); err == nil {
+		recordSets, err := zonefile.Parse(state.Attributes["zone_file"], privateZoneId.PrivateZoneName)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `zone_file`: %+v", err)
+		}
+
+		for _, v := range recordSets {
+			recordSetId := privateRecordSets.NewRecordTypeID(privateZoneId.SubscriptionId, privateZoneId.ResourceGroupName, privateZoneId.PrivateZoneName, privateRecordSets.RecordType(v.Type), v.Name)
+			resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, recordSetId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return utils.Bool(false), nil
+				}
+				return nil, fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+			}
+		}
+
+		return utils.Bool(true), nil
+	}
+
+	id, err := zones.ParseDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSets, err := zonefile.Parse(state.Attributes["zone_file"], id.ZoneName)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	for _, v := range recordSets {
+		recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.ZoneName, recordsets.RecordType(v.Type), v.Name)
+		resp, err := clients.Dns.RecordSets.Get(ctx, recordSetId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (DnsZoneRecordsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneRecordsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+$TTL 300
+www   IN  A      10.0.0.1
+          A      10.0.0.2
+mail  IN  CNAME  www
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+$TTL 3600
+; web servers
+www        IN  A      10.0.0.1
+www        IN  AAAA   2001:db8::1
+mail   600 IN  A      10.0.0.10
+@          IN  MX     10 mail
+@          IN  TXT    "v=spf1 mx -all"
+@          IN  CAA    0 issue "letsencrypt.org"
+_sip._tcp  IN  SRV    10 20 5060 sip.example.com.
+ftp        IN  CNAME  www
+sub        IN  NS     ns1.example.com.
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) withExistingRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "legacy"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["10.0.0.100"]
+}
+`, r.template(data))
+}
+
+func (DnsZoneRecordsResource) privateDnsZone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+  zone_file           = <<ZONE
+$TTL 300
+www        IN  A      10.0.0.1
+               A      10.0.0.2
+@          IN  MX     10 mail
+@          IN  TXT    "v=spf1 mx -all"
+_sip._tcp  IN  SRV    10 20 5060 sip.example.com.
+mail       IN  CNAME  www
+ZONE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
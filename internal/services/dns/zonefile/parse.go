@@ -0,0 +1,451 @@
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+type line struct {
+	number int
+
+	// inheritsOwner is true when the line began with whitespace, meaning the owner
+	// name of the previous record applies
+	inheritsOwner bool
+
+	tokens []token
+}
+
+// Parse parses the RFC 1035 zone file `input` for the zone `origin` into a list of Record Sets,
+// grouping records with the same name and type together
+func Parse(input string, origin string) ([]RecordSet, error) {
+	lines, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	currentOrigin := strings.ToLower(Fqdn(origin))
+	zoneOrigin := currentOrigin
+
+	var defaultTTL *int64
+	var lastTTL *int64
+	lastOwner := ""
+
+	output := make([]RecordSet, 0)
+	indexes := make(map[string]int)
+
+	for _, l := range lines {
+		first := l.tokens[0]
+		if !first.quoted && strings.HasPrefix(first.value, "$") {
+			switch strings.ToUpper(first.value) {
+			case "$ORIGIN":
+				if len(l.tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$ORIGIN` expects a single domain name", l.number)
+				}
+				currentOrigin = strings.ToLower(qualify(l.tokens[1].value, currentOrigin))
+
+			case "$TTL":
+				if len(l.tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$TTL` expects a single value", l.number)
+				}
+				ttl, err := parseTTL(l.tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", l.number, err)
+				}
+				defaultTTL = &ttl
+
+			default:
+				return nil, fmt.Errorf("line %d: the directive %q is not supported", l.number, first.value)
+			}
+			continue
+		}
+
+		tokens := l.tokens
+		owner := lastOwner
+		if !l.inheritsOwner {
+			owner = strings.ToLower(qualify(tokens[0].value, currentOrigin))
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: no owner name was specified and there is no previous record to inherit one from", l.number)
+		}
+		lastOwner = owner
+
+		// the TTL and class are both optional and may appear in either order
+		var ttl *int64
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if strings.EqualFold(tokens[0].value, "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if isClass(tokens[0].value) {
+				return nil, fmt.Errorf("line %d: only the `IN` class is supported but got %q", l.number, tokens[0].value)
+			}
+			if ttl == nil && startsWithDigit(tokens[0].value) {
+				v, err := parseTTL(tokens[0].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", l.number, err)
+				}
+				ttl = &v
+				tokens = tokens[1:]
+			}
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: expected a record type", l.number)
+		}
+		recordType := strings.ToUpper(tokens[0].value)
+		rdata := tokens[1:]
+
+		fields, err := parseRData(recordType, rdata, currentOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parsing %s record: %+v", l.number, recordType, err)
+		}
+
+		if ttl == nil {
+			switch {
+			case defaultTTL != nil:
+				ttl = defaultTTL
+			case lastTTL != nil:
+				ttl = lastTTL
+			default:
+				v := DefaultTTL
+				ttl = &v
+			}
+		}
+		lastTTL = ttl
+
+		name, err := relativeName(owner, zoneOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", l.number, err)
+		}
+
+		key := RecordSet{Name: name, Type: recordType}.Key()
+		if index, ok := indexes[key]; ok {
+			existing := output[index]
+			if existing.TTL != *ttl {
+				return nil, fmt.Errorf("line %d: the %s records for %q have differing TTLs (%d and %d) - all records within a record set must share the same TTL", l.number, recordType, name, existing.TTL, *ttl)
+			}
+			if recordType == "CNAME" || recordType == "SOA" {
+				return nil, fmt.Errorf("line %d: only a single %s record can be specified for %q", l.number, recordType, name)
+			}
+			output[index].Records = append(output[index].Records, fields)
+			continue
+		}
+
+		indexes[key] = len(output)
+		output = append(output, RecordSet{
+			Name:    name,
+			Type:    recordType,
+			TTL:     *ttl,
+			Records: [][]string{fields},
+		})
+	}
+
+	return output, nil
+}
+
+func tokenize(input string) ([]line, error) {
+	output := make([]line, 0)
+
+	lineNumber := 1
+	current := line{number: lineNumber}
+	atLineStart := true
+	depth := 0
+
+	var sb strings.Builder
+	inToken := false
+	inQuotes := false
+
+	flush := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token{value: sb.String(), quoted: inQuotes})
+			sb.Reset()
+			inToken = false
+		}
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if inQuotes {
+			switch c {
+			case '\\':
+				if i+1 < len(runes) {
+					i++
+					sb.WriteRune(runes[i])
+				}
+			case '"':
+				flush()
+				inQuotes = false
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+			default:
+				sb.WriteRune(c)
+			}
+			continue
+		}
+
+		switch {
+		case c == ';':
+			flush()
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+
+		case c == '"':
+			flush()
+			inToken = true
+			inQuotes = true
+
+		case c == '(':
+			flush()
+			depth++
+
+		case c == ')':
+			flush()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected `)`", lineNumber)
+			}
+			depth--
+
+		case c == '\n':
+			flush()
+			lineNumber++
+			if depth == 0 {
+				if len(current.tokens) > 0 {
+					output = append(output, current)
+				}
+				current = line{number: lineNumber}
+				atLineStart = true
+			}
+			continue
+
+		case unicode.IsSpace(c):
+			if atLineStart && len(current.tokens) == 0 && !inToken {
+				current.inheritsOwner = true
+			}
+			flush()
+
+		case c == '\\' && i+1 < len(runes):
+			inToken = true
+			sb.WriteRune(c)
+			i++
+			sb.WriteRune(runes[i])
+
+		default:
+			inToken = true
+			sb.WriteRune(c)
+		}
+
+		atLineStart = false
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unterminated `(`", lineNumber)
+	}
+
+	flush()
+	if len(current.tokens) > 0 {
+		output = append(output, current)
+	}
+
+	return output, nil
+}
+
+func parseRData(recordType string, tokens []token, origin string) ([]string, error) {
+	values := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		values = append(values, t.value)
+	}
+
+	expectFields := func(count int) error {
+		if len(values) != count {
+			return fmt.Errorf("expected %d fields but got %d", count, len(values))
+		}
+		return nil
+	}
+
+	switch recordType {
+	case "A":
+		if err := expectFields(1); err != nil {
+			return nil, err
+		}
+		if ip := net.ParseIP(values[0]); ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("%q is not a valid IPv4 address", values[0])
+		}
+		return values, nil
+
+	case "AAAA":
+		if err := expectFields(1); err != nil {
+			return nil, err
+		}
+		if ip := net.ParseIP(values[0]); ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("%q is not a valid IPv6 address", values[0])
+		}
+		return values, nil
+
+	case "CNAME", "NS", "PTR":
+		if err := expectFields(1); err != nil {
+			return nil, err
+		}
+		return []string{qualify(values[0], origin)}, nil
+
+	case "MX":
+		if err := expectFields(2); err != nil {
+			return nil, err
+		}
+		if err := validateInteger("preference", values[0], 0, 65535); err != nil {
+			return nil, err
+		}
+		return []string{values[0], qualify(values[1], origin)}, nil
+
+	case "SRV":
+		if err := expectFields(4); err != nil {
+			return nil, err
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateInteger(name, values[i], 0, 65535); err != nil {
+				return nil, err
+			}
+		}
+		return []string{values[0], values[1], values[2], qualify(values[3], origin)}, nil
+
+	case "CAA":
+		if err := expectFields(3); err != nil {
+			return nil, err
+		}
+		if err := validateInteger("flags", values[0], 0, 255); err != nil {
+			return nil, err
+		}
+		return values, nil
+
+	case "TXT":
+		if len(values) == 0 {
+			return nil, fmt.Errorf("expected at least one string")
+		}
+		return values, nil
+
+	case "SOA":
+		if err := expectFields(7); err != nil {
+			return nil, err
+		}
+		output := []string{qualify(values[0], origin), qualify(values[1], origin)}
+		for i, name := range []string{"serial", "refresh", "retry", "expire", "minimum"} {
+			v := values[i+2]
+			if i > 0 {
+				ttl, err := parseTTL(v)
+				if err != nil {
+					return nil, fmt.Errorf("parsing %s: %+v", name, err)
+				}
+				v = strconv.FormatInt(ttl, 10)
+			} else if err := validateInteger(name, v, 0, 4294967295); err != nil {
+				return nil, err
+			}
+			output = append(output, v)
+		}
+		return output, nil
+	}
+
+	return nil, fmt.Errorf("the record type %q is not supported, supported types are: %s", recordType, strings.Join(SupportedRecordTypes(), ", "))
+}
+
+// qualify returns the domain name `input` made absolute relative to `origin`
+func qualify(input string, origin string) string {
+	if input == "@" {
+		return origin
+	}
+	if strings.HasSuffix(input, ".") {
+		return input
+	}
+	return input + "." + origin
+}
+
+// relativeName returns the fully qualified name `fqdn` relative to the zone `origin`
+func relativeName(fqdn string, origin string) (string, error) {
+	if fqdn == origin {
+		return "@", nil
+	}
+	if !strings.HasSuffix(fqdn, "."+origin) {
+		return "", fmt.Errorf("the name %q is outside of the zone %q", fqdn, origin)
+	}
+	return strings.TrimSuffix(fqdn, "."+origin), nil
+}
+
+// parseTTL parses a TTL specified either in seconds or using BIND-style units (e.g. `1h30m`)
+func parseTTL(input string) (int64, error) {
+	if v, err := strconv.ParseInt(input, 10, 64); err == nil {
+		if v < 0 || v > 2147483647 {
+			return 0, fmt.Errorf("the TTL %q must be between 0 and 2147483647", input)
+		}
+		return v, nil
+	}
+
+	units := map[rune]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 24 * 60 * 60,
+		'w': 7 * 24 * 60 * 60,
+	}
+
+	total := int64(0)
+	digits := ""
+	for _, c := range strings.ToLower(input) {
+		if unicode.IsDigit(c) {
+			digits += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c]
+		if !ok || digits == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		v, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		digits = ""
+	}
+
+	if digits != "" || total > 2147483647 {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	return total, nil
+}
+
+func validateInteger(name string, input string, min int64, max int64) error {
+	v, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s %q is not an integer", name, input)
+	}
+	if v < min || v > max {
+		return fmt.Errorf("%s must be between %d and %d but got %d", name, min, max, v)
+	}
+	return nil
+}
+
+func isClass(input string) bool {
+	switch strings.ToUpper(input) {
+	case "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
+
+func startsWithDigit(input string) bool {
+	return input != "" && unicode.IsDigit(rune(input[0]))
+}
@@ -0,0 +1,196 @@
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// DefaultTTL is used for records which don't specify a TTL when no `$TTL` directive
+// or previous record has provided one
+const DefaultTTL int64 = 3600
+
+// RecordSet is a set of records sharing the same name and type within a zone
+type RecordSet struct {
+	// Name is the name of the record set relative to the zone origin, `@` denotes the apex
+	Name string
+
+	// Type is the (upper-case) record type, e.g. `A` or `MX`
+	Type string
+
+	TTL int64
+
+	// Records contains the RDATA fields of each record in presentation format, with any
+	// domain names fully qualified and TXT/CAA strings unquoted
+	Records [][]string
+}
+
+// Key returns a string uniquely identifying this Record Set within a zone
+func (r RecordSet) Key() string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(r.Name), r.Type)
+}
+
+// SupportedRecordTypes returns the record types which can be parsed and rendered
+func SupportedRecordTypes() []string {
+	return []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"}
+}
+
+// Equal returns whether the two lists of Record Sets contain the same records, ignoring
+// ordering and differences in the presentation of values (e.g. the case of domain names)
+func Equal(a, b []RecordSet) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	indexed := make(map[string]RecordSet, len(a))
+	for _, v := range a {
+		indexed[v.Key()] = v
+	}
+
+	for _, v := range b {
+		other, ok := indexed[v.Key()]
+		if !ok {
+			return false
+		}
+		if !RecordSetEqual(v, other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RecordSetEqual returns whether two Record Sets contain the same records with the same TTL
+func RecordSetEqual(a, b RecordSet) bool {
+	if a.Key() != b.Key() || a.TTL != b.TTL || len(a.Records) != len(b.Records) {
+		return false
+	}
+
+	left := canonicalRecords(a)
+	right := canonicalRecords(b)
+	for i := range left {
+		if left[i] != right[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func canonicalRecords(input RecordSet) []string {
+	output := make([]string, 0, len(input.Records))
+	for _, record := range input.Records {
+		fields := make([]string, len(record))
+		for i, v := range record {
+			fields[i] = canonicalField(input.Type, i, v)
+		}
+		output = append(output, strings.Join(fields, "\x00"))
+	}
+	sort.Strings(output)
+	return output
+}
+
+func canonicalField(recordType string, index int, value string) string {
+	if isDomainNameField(recordType, index) {
+		return strings.ToLower(Fqdn(value))
+	}
+
+	if recordType == "AAAA" {
+		return canonicalIPv6(value)
+	}
+
+	if recordType == "CAA" && index == 1 {
+		return strings.ToLower(value)
+	}
+
+	return value
+}
+
+func canonicalIPv6(input string) string {
+	if ip := net.ParseIP(input); ip != nil {
+		return ip.String()
+	}
+	return input
+}
+
+func isDomainNameField(recordType string, index int) bool {
+	switch recordType {
+	case "CNAME", "NS", "PTR":
+		return index == 0
+	case "MX":
+		return index == 1
+	case "SRV":
+		return index == 3
+	case "SOA":
+		return index == 0 || index == 1
+	}
+	return false
+}
+
+// Fqdn returns the specified domain name terminated with a `.`
+func Fqdn(input string) string {
+	if strings.HasSuffix(input, ".") {
+		return input
+	}
+	return input + "."
+}
+
+// Render returns the specified Record Sets in RFC 1035 zone file format
+func Render(origin string, recordSets []RecordSet) string {
+	sorted := make([]RecordSet, len(recordSets))
+	copy(sorted, recordSets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return recordSetSortKey(sorted[i]) < recordSetSortKey(sorted[j])
+	})
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("$ORIGIN %s\n", strings.ToLower(Fqdn(origin))))
+
+	for _, recordSet := range sorted {
+		for _, record := range recordSet.Records {
+			sb.WriteString(fmt.Sprintf("%s\t%d\tIN\t%s\t%s\n", recordSet.Name, recordSet.TTL, recordSet.Type, renderRData(recordSet.Type, record)))
+		}
+	}
+
+	return sb.String()
+}
+
+func recordSetSortKey(input RecordSet) string {
+	// the SOA and NS records at the apex are conventionally listed first
+	name := strings.ToLower(input.Name)
+	prefix := "2"
+	if name == "@" {
+		switch input.Type {
+		case "SOA":
+			prefix = "0"
+		case "NS":
+			prefix = "1"
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s", prefix, name, input.Type)
+}
+
+func renderRData(recordType string, fields []string) string {
+	switch recordType {
+	case "TXT":
+		quoted := make([]string, 0, len(fields))
+		for _, v := range fields {
+			quoted = append(quoted, quote(v))
+		}
+		return strings.Join(quoted, " ")
+
+	case "CAA":
+		if len(fields) == 3 {
+			return fmt.Sprintf("%s %s %s", fields[0], fields[1], quote(fields[2]))
+		}
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func quote(input string) string {
+	escaped := strings.ReplaceAll(input, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+	return `"` + escaped + `"`
+}
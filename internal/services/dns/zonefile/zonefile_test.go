@@ -0,0 +1,287 @@
+package zonefile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []RecordSet
+		Error    bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: []RecordSet{},
+		},
+		{
+			Name: "Comments Only",
+			Input: `
+; a comment
+   ; an indented comment
+`,
+			Expected: []RecordSet{},
+		},
+		{
+			Name: "Apex and Relative Names",
+			Input: `
+$TTL 300
+@      IN A     10.0.0.1
+www       A     10.0.0.2
+          A     10.0.0.3
+mail.example.com.  600 IN MX 10 mail
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}, {"10.0.0.3"}}},
+				{Name: "mail", Type: "MX", TTL: 600, Records: [][]string{{"10", "mail.example.com."}}},
+			},
+		},
+		{
+			Name: "Class Before TTL",
+			Input: `
+www IN 1h CNAME target.example.net.
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: "CNAME", TTL: 3600, Records: [][]string{{"target.example.net."}}},
+			},
+		},
+		{
+			Name: "Inherits Previous TTL",
+			Input: `
+a 120 A 10.0.0.1
+b     A 10.0.0.2
+`,
+			Expected: []RecordSet{
+				{Name: "a", Type: "A", TTL: 120, Records: [][]string{{"10.0.0.1"}}},
+				{Name: "b", Type: "A", TTL: 120, Records: [][]string{{"10.0.0.2"}}},
+			},
+		},
+		{
+			Name: "Default TTL",
+			Input: `
+a A 10.0.0.1
+`,
+			Expected: []RecordSet{
+				{Name: "a", Type: "A", TTL: DefaultTTL, Records: [][]string{{"10.0.0.1"}}},
+			},
+		},
+		{
+			Name: "Origin Directive",
+			Input: `
+$ORIGIN sub.example.com.
+host 60 A 10.0.0.1
+`,
+			Expected: []RecordSet{
+				{Name: "host.sub", Type: "A", TTL: 60, Records: [][]string{{"10.0.0.1"}}},
+			},
+		},
+		{
+			Name: "Multi-line SOA",
+			Input: `
+@ 3600 IN SOA ns1.example.com. hostmaster ( ; comment
+        2023010101 ; serial
+        1h         ; refresh
+        15m        ; retry
+        1w         ; expire
+        300 )      ; minimum
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: "SOA", TTL: 3600, Records: [][]string{{"ns1.example.com.", "hostmaster.example.com.", "2023010101", "3600", "900", "604800", "300"}}},
+			},
+		},
+		{
+			Name: "TXT and CAA Strings",
+			Input: `
+@ 300 TXT "v=spf1 include:example.net ~all" "second; \"string\""
+@ 300 CAA 0 issue "letsencrypt.org"
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: "TXT", TTL: 300, Records: [][]string{{"v=spf1 include:example.net ~all", `second; "string"`}}},
+				{Name: "@", Type: "CAA", TTL: 300, Records: [][]string{{"0", "issue", "letsencrypt.org"}}},
+			},
+		},
+		{
+			Name: "SRV and PTR and AAAA",
+			Input: `
+_sip._tcp 300 SRV 10 20 5060 sip
+ptr       300 PTR host.example.org.
+v6        300 AAAA 2001:db8::1
+`,
+			Expected: []RecordSet{
+				{Name: "_sip._tcp", Type: "SRV", TTL: 300, Records: [][]string{{"10", "20", "5060", "sip.example.com."}}},
+				{Name: "ptr", Type: "PTR", TTL: 300, Records: [][]string{{"host.example.org."}}},
+				{Name: "v6", Type: "AAAA", TTL: 300, Records: [][]string{{"2001:db8::1"}}},
+			},
+		},
+		{
+			Name:  "Unsupported Record Type",
+			Input: "@ 300 DNAME other.example.com.",
+			Error: true,
+		},
+		{
+			Name:  "Unsupported Directive",
+			Input: "$INCLUDE other.zone",
+			Error: true,
+		},
+		{
+			Name:  "Name Outside Zone",
+			Input: "www.example.org. 300 A 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name:  "Invalid IPv4 Address",
+			Input: "www 300 A 2001:db8::1",
+			Error: true,
+		},
+		{
+			Name: "Differing TTLs within Record Set",
+			Input: `
+www 300 A 10.0.0.1
+www 600 A 10.0.0.2
+`,
+			Error: true,
+		},
+		{
+			Name: "Multiple CNAMEs",
+			Input: `
+www 300 CNAME a.example.com.
+www 300 CNAME b.example.com.
+`,
+			Error: true,
+		},
+		{
+			Name:  "Unterminated Parenthesis",
+			Input: "@ 300 SOA ns1 hostmaster ( 1 2 3 4 5",
+			Error: true,
+		},
+		{
+			Name:  "Unterminated Quote",
+			Input: `@ 300 TXT "foo`,
+			Error: true,
+		},
+		{
+			Name:  "Non-IN Class",
+			Input: "@ 300 CH A 10.0.0.1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse(v.Input, "example.com")
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error for %q: %+v", v.Name, err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v for %q", v.Expected, actual, v.Name)
+		}
+	}
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	input := []RecordSet{
+		{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}, {"10.0.0.3"}}},
+		{Name: "@", Type: "NS", TTL: 172800, Records: [][]string{{"ns1-01.azure-dns.com."}}},
+		{Name: "@", Type: "SOA", TTL: 3600, Records: [][]string{{"ns1-01.azure-dns.com.", "azuredns-hostmaster.microsoft.com.", "1", "3600", "300", "2419200", "300"}}},
+		{Name: "@", Type: "TXT", TTL: 300, Records: [][]string{{"v=spf1 -all", `quoted "value"`}}},
+		{Name: "@", Type: "CAA", TTL: 300, Records: [][]string{{"0", "issue", "letsencrypt.org"}}},
+	}
+
+	rendered := Render("example.com", input)
+	parsed, err := Parse(rendered, "example.com")
+	if err != nil {
+		t.Fatalf("parsing rendered zone file: %+v\n%s", err, rendered)
+	}
+
+	if !Equal(input, parsed) {
+		t.Fatalf("expected the round-tripped records to match, rendered:\n%s", rendered)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Left     []RecordSet
+		Right    []RecordSet
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Left:     []RecordSet{},
+			Right:    []RecordSet{},
+			Expected: true,
+		},
+		{
+			Name: "Ordering and Casing",
+			Left: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}, {"10.0.0.2"}}},
+				{Name: "mail", Type: "CNAME", TTL: 300, Records: [][]string{{"Target.Example.com"}}},
+			},
+			Right: []RecordSet{
+				{Name: "mail", Type: "CNAME", TTL: 300, Records: [][]string{{"target.example.com."}}},
+				{Name: "WWW", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.2"}, {"10.0.0.1"}}},
+			},
+			Expected: true,
+		},
+		{
+			Name: "IPv6 Formatting",
+			Left: []RecordSet{
+				{Name: "v6", Type: "AAAA", TTL: 300, Records: [][]string{{"2001:0db8:0000:0000:0000:0000:0000:0001"}}},
+			},
+			Right: []RecordSet{
+				{Name: "v6", Type: "AAAA", TTL: 300, Records: [][]string{{"2001:db8::1"}}},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Differing TTL",
+			Left: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+			},
+			Right: []RecordSet{
+				{Name: "www", Type: "A", TTL: 600, Records: [][]string{{"10.0.0.1"}}},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Differing Records",
+			Left: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+			},
+			Right: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}, {"10.0.0.2"}}},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Differing Record Sets",
+			Left: []RecordSet{
+				{Name: "www", Type: "A", TTL: 300, Records: [][]string{{"10.0.0.1"}}},
+			},
+			Right: []RecordSet{
+				{Name: "www", Type: "AAAA", TTL: 300, Records: [][]string{{"2001:db8::1"}}},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := Equal(v.Left, v.Right); actual != v.Expected {
+			t.Fatalf("expected %t but got %t for %q", v.Expected, actual, v.Name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
@@ -411,7 +412,17 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"effective_rule_counts": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeInt,
+				},
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(firewallPolicyRuleCollectionGroupCustomizeDiff),
 	}
 }
 
@@ -439,6 +450,19 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
+	// the Firewall Policy is checked here rather than during the plan, since it may be created or updated within the same plan
+	policy, err := meta.(*clients.Client).Firewall.FirewallPolicyClient.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *policyId, err)
+	}
+	if errors := validateFirewallPolicyRuleCollectionGroupFeatures(firewallPolicyFeaturesFromPolicy(policy), d.Get("application_rule_collection").([]interface{}), d.Get("network_rule_collection").([]interface{})); len(errors) > 0 {
+		messages := make([]string, 0)
+		for _, v := range errors {
+			messages = append(messages, v.Error())
+		}
+		return fmt.Errorf("validating the Firewall Policy Rule Collection Group against %s:\n\n%s", *policyId, strings.Join(messages, "\n"))
+	}
+
 	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
@@ -457,6 +481,11 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	}
 	rulesCollections = append(rulesCollections, natRules...)
 
+	rulesCollections, err = mergeFirewallPolicyFilterRuleCollections(rulesCollections)
+	if err != nil {
+		return err
+	}
+
 	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
 
 	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
@@ -524,6 +553,16 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("setting `nat_rule_collection`: %+v", err)
 	}
 
+	ipGroupIds, _ := firewallPolicyReferencedIpGroupIds(applicationRuleCollections, networkRuleCollections, natRuleCollections)
+	// an IP Group referenced by the rules may have been deleted outside of Terraform, which shouldn't prevent this being read
+	ipGroupSizes, err := firewallPolicyIpGroupSizes(ctx, meta.(*clients.Client).Network.IPGroupsClient, ipGroupIds, true)
+	if err != nil {
+		return fmt.Errorf("expanding the IP Groups referenced by the rules: %+v", err)
+	}
+	if err := d.Set("effective_rule_counts", firewallPolicyRuleCollectionEffectiveRuleCounts(applicationRuleCollections, networkRuleCollections, natRuleCollections, ipGroupSizes)); err != nil {
+		return fmt.Errorf("setting `effective_rule_counts`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -723,38 +762,43 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 				action = string(rule.Action.Type)
 			}
 
-			result = map[string]interface{}{
-				"name":     name,
-				"priority": priority,
-				"action":   action,
-			}
-
 			if rule.Rules == nil || len(*rule.Rules) == 0 {
 				continue
 			}
 
-			// Determine the rule type based on the first rule's type
-			switch (*rule.Rules)[0].(type) {
-			case network.ApplicationRule:
-				appRules, err := flattenFirewallPolicyRuleApplication(rule.Rules)
+			// a Filter Rule Collection can contain both Application and Network Rules, in which case it's split into
+			// an `application_rule_collection` and a `network_rule_collection` block with the same name
+			applicationRules, networkRules, err := splitFirewallPolicyFilterRuleCollection(*rule.Rules)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			if len(*applicationRules) > 0 {
+				appRules, err := flattenFirewallPolicyRuleApplication(applicationRules)
 				if err != nil {
 					return nil, nil, nil, err
 				}
-				result["rule"] = appRules
 
-				applicationRuleCollection = append(applicationRuleCollection, result)
+				applicationRuleCollection = append(applicationRuleCollection, map[string]interface{}{
+					"name":     name,
+					"priority": priority,
+					"action":   action,
+					"rule":     appRules,
+				})
+			}
 
-			case network.Rule:
-				networkRules, err := flattenFirewallPolicyRuleNetwork(rule.Rules)
+			if len(*networkRules) > 0 {
+				netRules, err := flattenFirewallPolicyRuleNetwork(networkRules)
 				if err != nil {
 					return nil, nil, nil, err
 				}
-				result["rule"] = networkRules
-
-				networkRuleCollection = append(networkRuleCollection, result)
 
-			default:
-				return nil, nil, nil, fmt.Errorf("unknown rule condition type %+v", (*rule.Rules)[0])
+				networkRuleCollection = append(networkRuleCollection, map[string]interface{}{
+					"name":     name,
+					"priority": priority,
+					"action":   action,
+					"rule":     netRules,
+				})
 			}
 		case network.FirewallPolicyNatRuleCollection:
 			var name string
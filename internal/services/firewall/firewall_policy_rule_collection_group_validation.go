@@ -0,0 +1,349 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// firewallPolicyMaxIpGroupEntries is the maximum number of IP Addresses/CIDRs which can be contained within an IP Group
+const firewallPolicyMaxIpGroupEntries = 5000
+
+// firewallPolicyMaxEffectiveNetworkRules is the maximum number of unique source/destination/port combinations across the
+// Network Rules within a Firewall, since this applies across the Firewall any Rule Collection Group exceeding it will fail
+const firewallPolicyMaxEffectiveNetworkRules = 10000
+
+// firewallPolicyFeatures are the properties of the parent Firewall Policy which determine the features available to its Rules
+type firewallPolicyFeatures struct {
+	Tier                 string
+	DnsProxyEnabled      bool
+	TlsInspectionEnabled bool
+}
+
+func firewallPolicyFeaturesFromPolicy(input network.FirewallPolicy) firewallPolicyFeatures {
+	output := firewallPolicyFeatures{
+		Tier: string(network.FirewallPolicySkuTierStandard),
+	}
+
+	if props := input.FirewallPolicyPropertiesFormat; props != nil {
+		if props.Sku != nil && props.Sku.Tier != "" {
+			output.Tier = string(props.Sku.Tier)
+		}
+		if props.DNSSettings != nil && props.DNSSettings.EnableProxy != nil {
+			output.DnsProxyEnabled = *props.DNSSettings.EnableProxy
+		}
+		if props.TransportSecurity != nil && props.TransportSecurity.CertificateAuthority != nil {
+			output.TlsInspectionEnabled = true
+		}
+	}
+
+	return output
+}
+
+// validateFirewallPolicyRuleCollectionGroupFeatures checks that the rules within the Rule Collection Group only use
+// features which are available on the parent Firewall Policy
+func validateFirewallPolicyRuleCollectionGroupFeatures(features firewallPolicyFeatures, applicationRuleCollections []interface{}, networkRuleCollections []interface{}) []error {
+	errors := make([]error, 0)
+	isPremium := strings.EqualFold(features.Tier, string(network.FirewallPolicySkuTierPremium))
+	isBasic := strings.EqualFold(features.Tier, string(network.FirewallPolicySkuTierBasic))
+
+	for _, collection := range firewallPolicyRules(applicationRuleCollections) {
+		rule := collection.rule
+		if rule["terminate_tls"].(bool) {
+			if !isPremium {
+				errors = append(errors, fmt.Errorf("`terminate_tls` in the rule %q within the application rule collection %q requires a Firewall Policy with the `Premium` SKU but got %q", rule["name"], collection.name, features.Tier))
+			} else if !features.TlsInspectionEnabled {
+				errors = append(errors, fmt.Errorf("`terminate_tls` in the rule %q within the application rule collection %q requires TLS inspection to be configured on the Firewall Policy using the `tls_certificate` block", rule["name"], collection.name))
+			}
+		}
+
+		if urls := rule["destination_urls"].([]interface{}); len(urls) > 0 {
+			if !isPremium {
+				errors = append(errors, fmt.Errorf("`destination_urls` in the rule %q within the application rule collection %q requires a Firewall Policy with the `Premium` SKU but got %q", rule["name"], collection.name, features.Tier))
+			} else if !rule["terminate_tls"].(bool) && firewallPolicyApplicationRuleUsesHttps(rule) {
+				errors = append(errors, fmt.Errorf("`destination_urls` in the rule %q within the application rule collection %q requires `terminate_tls` to be enabled when the `Https` protocol is used", rule["name"], collection.name))
+			}
+		}
+
+		if categories := rule["web_categories"].([]interface{}); len(categories) > 0 && isBasic {
+			errors = append(errors, fmt.Errorf("`web_categories` in the rule %q within the application rule collection %q aren't supported by a Firewall Policy with the `Basic` SKU", rule["name"], collection.name))
+		}
+	}
+
+	for _, collection := range firewallPolicyRules(networkRuleCollections) {
+		rule := collection.rule
+		if fqdns := rule["destination_fqdns"].([]interface{}); len(fqdns) > 0 && !features.DnsProxyEnabled {
+			errors = append(errors, fmt.Errorf("`destination_fqdns` in the rule %q within the network rule collection %q requires the DNS Proxy to be enabled on the Firewall Policy using `dns.0.proxy_enabled`", rule["name"], collection.name))
+		}
+	}
+
+	return errors
+}
+
+// firewallPolicyApplicationRuleUsesHttps returns whether the `protocols` of the Application Rule include `Https`
+func firewallPolicyApplicationRuleUsesHttps(rule map[string]interface{}) bool {
+	protocols, _ := rule["protocols"].([]interface{})
+	for _, raw := range protocols {
+		protocol, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if strings.EqualFold(protocol["type"].(string), string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFirewallPolicyRuleCollectionGroupLimits checks the size of the referenced IP Groups and the effective number of
+// Network Rules once the IP Groups have been expanded
+func validateFirewallPolicyRuleCollectionGroupLimits(ipGroupSizes map[string]int, networkRuleCollections []interface{}) []error {
+	errors := make([]error, 0)
+
+	for id, size := range ipGroupSizes {
+		if size > firewallPolicyMaxIpGroupEntries {
+			errors = append(errors, fmt.Errorf("the IP Group %q contains %d entries but an IP Group referenced by a Firewall Policy can contain at most %d", id, size, firewallPolicyMaxIpGroupEntries))
+		}
+	}
+
+	total := 0
+	for _, v := range firewallPolicyRuleCollectionEffectiveRuleCounts(nil, networkRuleCollections, nil, ipGroupSizes) {
+		total += v
+	}
+	if total > firewallPolicyMaxEffectiveNetworkRules {
+		errors = append(errors, fmt.Errorf("the network rule collections expand to %d effective rules (source addresses x destination addresses x destination ports, with IP Groups expanded) but at most %d are supported", total, firewallPolicyMaxEffectiveNetworkRules))
+	}
+
+	return errors
+}
+
+// firewallPolicyRuleCollectionEffectiveRuleCounts returns the effective number of rules within each Rule Collection, keyed by
+// the name of the Rule Collection - that is the number of source, destination and port/protocol combinations with any IP Groups
+// expanded to the number of entries they contain
+func firewallPolicyRuleCollectionEffectiveRuleCounts(applicationRuleCollections []interface{}, networkRuleCollections []interface{}, natRuleCollections []interface{}, ipGroupSizes map[string]int) map[string]int {
+	output := make(map[string]int)
+
+	count := func(rule map[string]interface{}, key string) int {
+		if v, ok := rule[key].([]interface{}); ok {
+			return len(v)
+		}
+		return 0
+	}
+	countIpGroups := func(rule map[string]interface{}, key string) int {
+		total := 0
+		if v, ok := rule[key].([]interface{}); ok {
+			for _, id := range v {
+				total += ipGroupSizes[strings.ToLower(id.(string))]
+			}
+		}
+		return total
+	}
+	atLeastOne := func(input int) int {
+		if input == 0 {
+			return 1
+		}
+		return input
+	}
+
+	for _, collection := range firewallPolicyRules(applicationRuleCollections) {
+		rule := collection.rule
+		sources := count(rule, "source_addresses") + countIpGroups(rule, "source_ip_groups")
+		destinations := count(rule, "destination_addresses") + count(rule, "destination_fqdns") + count(rule, "destination_urls") + count(rule, "destination_fqdn_tags") + count(rule, "web_categories")
+		output[collection.name] += atLeastOne(sources) * atLeastOne(destinations) * atLeastOne(count(rule, "protocols"))
+	}
+
+	for _, collection := range firewallPolicyRules(networkRuleCollections) {
+		rule := collection.rule
+		sources := count(rule, "source_addresses") + countIpGroups(rule, "source_ip_groups")
+		destinations := count(rule, "destination_addresses") + count(rule, "destination_fqdns") + countIpGroups(rule, "destination_ip_groups")
+		output[collection.name] += atLeastOne(sources) * atLeastOne(destinations) * atLeastOne(count(rule, "destination_ports"))
+	}
+
+	for _, collection := range firewallPolicyRules(natRuleCollections) {
+		rule := collection.rule
+		sources := count(rule, "source_addresses") + countIpGroups(rule, "source_ip_groups")
+		output[collection.name] += atLeastOne(sources) * atLeastOne(count(rule, "destination_ports"))
+	}
+
+	return output
+}
+
+type firewallPolicyRuleWithinCollection struct {
+	name string
+	rule map[string]interface{}
+}
+
+// firewallPolicyRules flattens the rules within the specified Rule Collections, alongside the name of their Rule Collection
+func firewallPolicyRules(collections []interface{}) []firewallPolicyRuleWithinCollection {
+	output := make([]firewallPolicyRuleWithinCollection, 0)
+	for _, raw := range collections {
+		collection, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := collection["name"].(string)
+		rules, _ := collection["rule"].([]interface{})
+		for _, r := range rules {
+			if rule, ok := r.(map[string]interface{}); ok {
+				output = append(output, firewallPolicyRuleWithinCollection{
+					name: name,
+					rule: rule,
+				})
+			}
+		}
+	}
+	return output
+}
+
+// firewallPolicyReferencedIpGroupIds returns the IDs of the IP Groups referenced within the specified Rule Collections,
+// returning false if any of these aren't yet known
+func firewallPolicyReferencedIpGroupIds(collections ...[]interface{}) ([]networkParse.IpGroupId, bool) {
+	output := make([]networkParse.IpGroupId, 0)
+	seen := make(map[string]struct{})
+
+	for _, c := range collections {
+		for _, collection := range firewallPolicyRules(c) {
+			for _, key := range []string{"source_ip_groups", "destination_ip_groups"} {
+				v, ok := collection.rule[key].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, raw := range v {
+					id, err := networkParse.IpGroupID(raw.(string))
+					if err != nil {
+						return nil, false
+					}
+					if _, ok := seen[strings.ToLower(id.ID())]; ok {
+						continue
+					}
+					seen[strings.ToLower(id.ID())] = struct{}{}
+					output = append(output, *id)
+				}
+			}
+		}
+	}
+
+	return output, true
+}
+
+// firewallPolicyIpGroupSizes retrieves the number of entries within each of the specified IP Groups, keyed by the lower-cased
+// ID of the IP Group - when `ignoreMissing` is true any IP Groups which don't exist are treated as being empty
+func firewallPolicyIpGroupSizes(ctx context.Context, client *network.IPGroupsClient, ids []networkParse.IpGroupId, ignoreMissing bool) (map[string]int, error) {
+	output := make(map[string]int)
+	for _, id := range ids {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				if ignoreMissing {
+					log.Printf("[DEBUG] %s was not found - assuming it contains no entries", id)
+					output[strings.ToLower(id.ID())] = 0
+					continue
+				}
+				return nil, fmt.Errorf("%s was not found", id)
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		size := 0
+		if props := resp.IPGroupPropertiesFormat; props != nil && props.IPAddresses != nil {
+			size = len(*props.IPAddresses)
+		}
+		output[strings.ToLower(id.ID())] = size
+	}
+	return output, nil
+}
+
+func firewallPolicyRuleCollectionGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	applicationRuleCollections := d.Get("application_rule_collection").([]interface{})
+	networkRuleCollections := d.Get("network_rule_collection").([]interface{})
+	natRuleCollections := d.Get("nat_rule_collection").([]interface{})
+
+	if d.HasChanges("application_rule_collection", "network_rule_collection", "nat_rule_collection") {
+		if err := d.SetNewComputed("effective_rule_counts"); err != nil {
+			return fmt.Errorf("setting `effective_rule_counts`: %+v", err)
+		}
+	}
+
+	errors := make([]string, 0)
+
+	// the IP Groups can only be expanded once they all exist - the Firewall Policy itself is checked during the apply, since
+	// it may be created or updated within the same plan
+	if ipGroupIds, ok := firewallPolicyReferencedIpGroupIds(applicationRuleCollections, networkRuleCollections, natRuleCollections); ok {
+		ipGroupSizes, err := firewallPolicyIpGroupSizes(ctx, meta.(*clients.Client).Network.IPGroupsClient, ipGroupIds, false)
+		if err != nil {
+			return fmt.Errorf("expanding the IP Groups referenced by the rules: %+v", err)
+		}
+		for _, v := range validateFirewallPolicyRuleCollectionGroupLimits(ipGroupSizes, networkRuleCollections) {
+			errors = append(errors, v.Error())
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validating the Firewall Policy Rule Collection Group against the referenced IP Groups:\n\n%s", strings.Join(errors, "\n"))
+	}
+
+	return nil
+}
+
+// splitFirewallPolicyFilterRuleCollection splits the rules within a Filter Rule Collection into the Application Rules and
+// the Network Rules, since the API allows a Filter Rule Collection to contain both whereas these are represented by separate
+// `application_rule_collection` and `network_rule_collection` blocks (sharing the same name) within this resource
+func splitFirewallPolicyFilterRuleCollection(input []network.BasicFirewallPolicyRule) (*[]network.BasicFirewallPolicyRule, *[]network.BasicFirewallPolicyRule, error) {
+	applicationRules := make([]network.BasicFirewallPolicyRule, 0)
+	networkRules := make([]network.BasicFirewallPolicyRule, 0)
+	for _, rule := range input {
+		switch rule.(type) {
+		case network.ApplicationRule:
+			applicationRules = append(applicationRules, rule)
+		case network.Rule:
+			networkRules = append(networkRules, rule)
+		default:
+			return nil, nil, fmt.Errorf("unknown rule condition type %+v", rule)
+		}
+	}
+	return &applicationRules, &networkRules, nil
+}
+
+// mergeFirewallPolicyFilterRuleCollections combines any Filter Rule Collections sharing the same name into a single Filter
+// Rule Collection, which allows an existing Filter Rule Collection containing both Application and Network Rules to be
+// managed as an `application_rule_collection` and a `network_rule_collection` block with the same name
+func mergeFirewallPolicyFilterRuleCollections(input []network.BasicFirewallPolicyRuleCollection) ([]network.BasicFirewallPolicyRuleCollection, error) {
+	output := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	existing := make(map[string]*network.FirewallPolicyFilterRuleCollection)
+	for _, v := range input {
+		collection, ok := v.(*network.FirewallPolicyFilterRuleCollection)
+		if !ok || collection.Name == nil {
+			output = append(output, v)
+			continue
+		}
+
+		key := strings.ToLower(*collection.Name)
+		other, ok := existing[key]
+		if !ok {
+			existing[key] = collection
+			output = append(output, collection)
+			continue
+		}
+
+		if *other.Priority != *collection.Priority || other.Action.Type != collection.Action.Type {
+			return nil, fmt.Errorf("the `application_rule_collection` and `network_rule_collection` named %q must use the same `priority` and `action`", *collection.Name)
+		}
+
+		rules := make([]network.BasicFirewallPolicyRule, 0)
+		if other.Rules != nil {
+			rules = append(rules, *other.Rules...)
+		}
+		if collection.Rules != nil {
+			rules = append(rules, *collection.Rules...)
+		}
+		other.Rules = &rules
+	}
+	return output, nil
+}
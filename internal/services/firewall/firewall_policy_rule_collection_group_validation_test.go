@@ -0,0 +1,295 @@
+package firewall
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestValidateFirewallPolicyRuleCollectionGroupFeatures(t *testing.T) {
+	applicationRule := func(terminateTls bool, protocol string, urls []interface{}, webCategories []interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name": "collection",
+				"rule": []interface{}{
+					map[string]interface{}{
+						"name": "rule",
+						"protocols": []interface{}{
+							map[string]interface{}{
+								"type": protocol,
+								"port": 443,
+							},
+						},
+						"terminate_tls":    terminateTls,
+						"destination_urls": urls,
+						"web_categories":   webCategories,
+					},
+				},
+			},
+		}
+	}
+	networkRule := func(fqdns []interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name": "collection",
+				"rule": []interface{}{
+					map[string]interface{}{
+						"name":              "rule",
+						"destination_fqdns": fqdns,
+					},
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		Name        string
+		Features    firewallPolicyFeatures
+		Application []interface{}
+		Network     []interface{}
+		Errors      int
+	}{
+		{
+			Name:        "Standard without Premium Features",
+			Features:    firewallPolicyFeatures{Tier: "Standard"},
+			Application: applicationRule(false, "Https", []interface{}{}, []interface{}{"gambling"}),
+			Network:     networkRule([]interface{}{}),
+		},
+		{
+			Name:        "Terminate TLS on Standard",
+			Features:    firewallPolicyFeatures{Tier: "Standard"},
+			Application: applicationRule(true, "Https", []interface{}{}, []interface{}{}),
+			Errors:      1,
+		},
+		{
+			Name:        "Terminate TLS on Premium without TLS Inspection",
+			Features:    firewallPolicyFeatures{Tier: "Premium"},
+			Application: applicationRule(true, "Https", []interface{}{}, []interface{}{}),
+			Errors:      1,
+		},
+		{
+			Name:        "Terminate TLS and URLs on Premium with TLS Inspection",
+			Features:    firewallPolicyFeatures{Tier: "Premium", TlsInspectionEnabled: true},
+			Application: applicationRule(true, "Https", []interface{}{"www.example.com/path"}, []interface{}{}),
+		},
+		{
+			Name:        "URLs on Premium without Terminate TLS",
+			Features:    firewallPolicyFeatures{Tier: "Premium", TlsInspectionEnabled: true},
+			Application: applicationRule(false, "Https", []interface{}{"www.example.com/path"}, []interface{}{}),
+			Errors:      1,
+		},
+		{
+			Name:        "URLs on Premium over Http without Terminate TLS",
+			Features:    firewallPolicyFeatures{Tier: "Premium", TlsInspectionEnabled: true},
+			Application: applicationRule(false, "Http", []interface{}{"www.example.com/path"}, []interface{}{}),
+		},
+		{
+			Name:        "Web Categories on Basic",
+			Features:    firewallPolicyFeatures{Tier: "Basic"},
+			Application: applicationRule(false, "Https", []interface{}{}, []interface{}{"gambling"}),
+			Errors:      1,
+		},
+		{
+			Name:     "Network Rule FQDNs without DNS Proxy",
+			Features: firewallPolicyFeatures{Tier: "Standard"},
+			Network:  networkRule([]interface{}{"time.windows.com"}),
+			Errors:   1,
+		},
+		{
+			Name:     "Network Rule FQDNs with DNS Proxy",
+			Features: firewallPolicyFeatures{Tier: "Standard", DnsProxyEnabled: true},
+			Network:  networkRule([]interface{}{"time.windows.com"}),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := validateFirewallPolicyRuleCollectionGroupFeatures(v.Features, v.Application, v.Network)
+		if len(actual) != v.Errors {
+			t.Fatalf("expected %d errors but got %d for %q: %+v", v.Errors, len(actual), v.Name, actual)
+		}
+	}
+}
+
+func TestFirewallPolicyRuleCollectionEffectiveRuleCounts(t *testing.T) {
+	ipGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/ipGroups/group1"
+	ipGroupSizes := map[string]int{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/ipgroups/group1": 3,
+	}
+
+	application := []interface{}{
+		map[string]interface{}{
+			"name": "app",
+			"rule": []interface{}{
+				map[string]interface{}{
+					"protocols":         []interface{}{map[string]interface{}{}, map[string]interface{}{}},
+					"source_addresses":  []interface{}{"10.0.0.1"},
+					"destination_fqdns": []interface{}{"a.example.com", "b.example.com"},
+				},
+			},
+		},
+	}
+	network := []interface{}{
+		map[string]interface{}{
+			"name": "network",
+			"rule": []interface{}{
+				map[string]interface{}{
+					"source_ip_groups":      []interface{}{ipGroupId},
+					"destination_addresses": []interface{}{"192.168.0.1", "192.168.0.2"},
+					"destination_ports":     []interface{}{"80", "443"},
+				},
+				map[string]interface{}{
+					"source_addresses":  []interface{}{"*"},
+					"destination_ports": []interface{}{"*"},
+				},
+			},
+		},
+	}
+	nat := []interface{}{
+		map[string]interface{}{
+			"name": "nat",
+			"rule": []interface{}{
+				map[string]interface{}{
+					"source_addresses":  []interface{}{"10.0.0.1", "10.0.0.2"},
+					"destination_ports": []interface{}{"80"},
+				},
+			},
+		},
+	}
+
+	expected := map[string]int{
+		"app":     4,
+		"network": 13,
+		"nat":     2,
+	}
+	actual := firewallPolicyRuleCollectionEffectiveRuleCounts(application, network, nat, ipGroupSizes)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if errors := validateFirewallPolicyRuleCollectionGroupLimits(map[string]int{ipGroupId: firewallPolicyMaxIpGroupEntries + 1}, nil); len(errors) != 1 {
+		t.Fatalf("expected 1 error for an oversized IP Group but got %d", len(errors))
+	}
+}
+
+func TestSplitFirewallPolicyFilterRuleCollection(t *testing.T) {
+	rules := []network.BasicFirewallPolicyRule{
+		network.ApplicationRule{Name: utils.String("app1")},
+		network.Rule{Name: utils.String("network1")},
+		network.ApplicationRule{Name: utils.String("app2")},
+	}
+
+	applicationRules, networkRules, err := splitFirewallPolicyFilterRuleCollection(rules)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if len(*applicationRules) != 2 {
+		t.Fatalf("expected 2 Application Rules but got %d", len(*applicationRules))
+	}
+	if len(*networkRules) != 1 {
+		t.Fatalf("expected 1 Network Rule but got %d", len(*networkRules))
+	}
+
+	if _, _, err := splitFirewallPolicyFilterRuleCollection([]network.BasicFirewallPolicyRule{network.NatRule{}}); err == nil {
+		t.Fatalf("expected an error for a NAT Rule but didn't get one")
+	}
+}
+
+func TestMergeFirewallPolicyFilterRuleCollections(t *testing.T) {
+	collection := func(name string, priority int32, action network.FirewallPolicyFilterRuleCollectionActionType, rules ...network.BasicFirewallPolicyRule) *network.FirewallPolicyFilterRuleCollection {
+		return &network.FirewallPolicyFilterRuleCollection{
+			Name:     utils.String(name),
+			Priority: utils.Int32(priority),
+			Action: &network.FirewallPolicyFilterRuleCollectionAction{
+				Type: action,
+			},
+			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyFilterRuleCollection,
+			Rules:              &rules,
+		}
+	}
+	nat := &network.FirewallPolicyNatRuleCollection{
+		Name:               utils.String("nat"),
+		RuleCollectionType: network.RuleCollectionTypeFirewallPolicyNatRuleCollection,
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []network.BasicFirewallPolicyRuleCollection
+		Expected map[string]int
+		Error    bool
+	}{
+		{
+			Name: "Distinct Names",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("app", 100, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.ApplicationRule{}),
+				collection("network", 200, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.Rule{}),
+				nat,
+			},
+			Expected: map[string]int{
+				"app":     1,
+				"network": 1,
+				"nat":     0,
+			},
+		},
+		{
+			Name: "Shared Name",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("mixed", 100, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.ApplicationRule{}, network.ApplicationRule{}),
+				collection("Mixed", 100, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.Rule{}),
+				nat,
+			},
+			Expected: map[string]int{
+				"mixed": 3,
+				"nat":   0,
+			},
+		},
+		{
+			Name: "Shared Name with different Priorities",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("mixed", 100, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.ApplicationRule{}),
+				collection("mixed", 200, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.Rule{}),
+			},
+			Error: true,
+		},
+		{
+			Name: "Shared Name with different Actions",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("mixed", 100, network.FirewallPolicyFilterRuleCollectionActionTypeAllow, network.ApplicationRule{}),
+				collection("mixed", 100, network.FirewallPolicyFilterRuleCollectionActionTypeDeny, network.Rule{}),
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		output, err := mergeFirewallPolicyFilterRuleCollections(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		actual := make(map[string]int)
+		for _, c := range output {
+			switch collection := c.(type) {
+			case *network.FirewallPolicyFilterRuleCollection:
+				actual[*collection.Name] = len(*collection.Rules)
+			case *network.FirewallPolicyNatRuleCollection:
+				actual[*collection.Name] = 0
+			}
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
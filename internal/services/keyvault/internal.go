@@ -6,9 +6,11 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -148,3 +150,49 @@ func nestedItemResourceImporter(ctx context.Context, d *pluginsdk.ResourceData,
 
 	return []*pluginsdk.ResourceData{d}, nil
 }
+
+// nestedItemParallelism is the maximum number of concurrent requests made against a Key Vault's Data Plane when
+// working with multiple Nested Items at once, to avoid hitting the Key Vault service limits
+const nestedItemParallelism = 10
+
+// forEachNestedItemConcurrently calls `fn` for each of the Nested Item names specified, running at most
+// `nestedItemParallelism` calls at once - returning all of the errors which occurred once every call has completed
+func forEachNestedItemConcurrently(names []string, fn func(name string) error) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	items := make(chan string, len(names))
+	for _, name := range names {
+		items <- name
+	}
+	close(items)
+
+	workerCount := nestedItemParallelism
+	if len(names) < workerCount {
+		workerCount = len(names)
+	}
+
+	errors := make(chan error, len(names))
+	wg := &sync.WaitGroup{}
+	wg.Add(workerCount)
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			defer wg.Done()
+			for name := range items {
+				if err := fn(name); err != nil {
+					errors <- err
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errors)
+
+	var result *multierror.Error
+	for err := range errors {
+		result = multierror.Append(result, err)
+	}
+	return result.ErrorOrNil()
+}
@@ -155,41 +155,9 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.SecretAttributes.Expires = &expirationUnixTime
 	}
 
-	if resp, err := client.SetSecret(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
-		// In the case that the Secret already exists in a Soft Deleted / Recoverable state we check if `recover_soft_deleted_key_vaults` is set
-		// and attempt recovery where appropriate
-		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedSecrets && utils.ResponseWasConflict(resp.Response) {
-			recoveredSecret, err := client.RecoverDeletedSecret(ctx, *keyVaultBaseUrl, name)
-			if err != nil {
-				return err
-			}
-			log.Printf("[DEBUG] Recovering Secret %q with ID: %q", name, *recoveredSecret.ID)
-			// We need to wait for consistency, recovered Key Vault Child items are not as readily available as newly created
-			if secret := recoveredSecret.ID; secret != nil {
-				stateConf := &pluginsdk.StateChangeConf{
-					Pending:                   []string{"pending"},
-					Target:                    []string{"available"},
-					Refresh:                   keyVaultChildItemRefreshFunc(*secret),
-					Delay:                     30 * time.Second,
-					PollInterval:              10 * time.Second,
-					ContinuousTargetOccurence: 10,
-					Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
-				}
-
-				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-					return fmt.Errorf("waiting for Key Vault Secret %q to become available: %s", name, err)
-				}
-				log.Printf("[DEBUG] Secret %q recovered with ID: %q", name, *recoveredSecret.ID)
-
-				_, err := client.SetSecret(ctx, *keyVaultBaseUrl, name, parameters)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			// If the error response was anything else, or `recover_soft_deleted_key_vaults` is `false` just return the error
-			return err
-		}
+	recoverSoftDeleted := meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedSecrets
+	if err := setSecretWithOptionalRecovery(ctx, client, *keyVaultBaseUrl, name, parameters, recoverSoftDeleted, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+		return err
 	}
 
 	// "" indicates the latest version
@@ -423,6 +391,49 @@ func resourceKeyVaultSecretDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+// setSecretWithOptionalRecovery sets the value of the Secret - in the case that the Secret already exists in a
+// Soft Deleted / Recoverable state and `recoverSoftDeleted` is set, the Secret is recovered prior to being set
+func setSecretWithOptionalRecovery(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl, name string, parameters keyvault.SecretSetParameters, recoverSoftDeleted bool, timeout time.Duration) error {
+	resp, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters)
+	if err == nil {
+		return nil
+	}
+
+	// If the error response was anything else, or `recover_soft_deleted_key_vaults` is `false` just return the error
+	if !recoverSoftDeleted || !utils.ResponseWasConflict(resp.Response) {
+		return err
+	}
+
+	recoveredSecret, err := client.RecoverDeletedSecret(ctx, keyVaultBaseUrl, name)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Recovering Secret %q with ID: %q", name, *recoveredSecret.ID)
+	// We need to wait for consistency, recovered Key Vault Child items are not as readily available as newly created
+	if secret := recoveredSecret.ID; secret != nil {
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:                   []string{"pending"},
+			Target:                    []string{"available"},
+			Refresh:                   keyVaultChildItemRefreshFunc(*secret),
+			Delay:                     30 * time.Second,
+			PollInterval:              10 * time.Second,
+			ContinuousTargetOccurence: 10,
+			Timeout:                   timeout,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for Key Vault Secret %q to become available: %s", name, err)
+		}
+		log.Printf("[DEBUG] Secret %q recovered with ID: %q", name, *recoveredSecret.ID)
+
+		if _, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 var _ deleteAndPurgeNestedItem = deleteAndPurgeSecret{}
 
 type deleteAndPurgeSecret struct {
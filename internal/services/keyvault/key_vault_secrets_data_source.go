@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -29,6 +33,20 @@ func dataSourceKeyVaultSecrets() *pluginsdk.Resource {
 				ValidateFunc: keyVaultValidate.VaultID,
 			},
 
+			"name_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"required_tags": tags.Schema(),
+
+			"include_values": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"names": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -36,6 +54,31 @@ func dataSourceKeyVaultSecrets() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"values": {
+				Type:      pluginsdk.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"versions": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"content_types": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -63,30 +106,114 @@ func dataSourceKeyVaultSecretsRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.SetId(keyVaultId.ID())
 
-	var names []string
+	namePrefix := d.Get("name_prefix").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
 
-	if secretList.Response().Value != nil {
-		for secretList.NotDone() {
-			for _, v := range *secretList.Response().Value {
-				name, err := parseNameFromSecretUrl(*v.ID)
+	items := make([]keyvault.SecretItem, 0)
+	for secretList.NotDone() {
+		items = append(items, secretList.Value())
+		if err := secretList.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing secrets on Azure KeyVault %q: %+v", *keyVaultId, err)
+		}
+	}
+
+	names, enabledNames, contentTypes, err := filterKeyVaultSecretItems(items, namePrefix, requiredTags)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]interface{})
+	versions := make(map[string]interface{})
+	if d.Get("include_values").(bool) {
+		// the values (and current versions) aren't returned when listing the Secrets, so each is retrieved individually
+		var mutex sync.Mutex
+		err := forEachNestedItemConcurrently(enabledNames, func(name string) error {
+			// "" indicates the latest version
+			resp, err := client.GetSecret(ctx, *keyVaultBaseUri, name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+			}
+
+			version := ""
+			if resp.ID != nil {
+				id, err := parse.ParseNestedItemID(*resp.ID)
 				if err != nil {
 					return err
 				}
-				names = append(names, *name)
-				err = secretList.NextWithContext(ctx)
-				if err != nil {
-					return fmt.Errorf("listing secrets on Azure KeyVault %q: %+v", *keyVaultId, err)
-				}
+				version = id.Version
+			}
+
+			value := ""
+			if resp.Value != nil {
+				value = *resp.Value
 			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			values[name] = value
+			versions[name] = version
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 
 	d.Set("names", names)
+	d.Set("values", values)
+	d.Set("versions", versions)
+	d.Set("content_types", contentTypes)
 	d.Set("key_vault_id", keyVaultId.ID())
 
 	return nil
 }
 
+// filterKeyVaultSecretItems returns the names of the Secrets matching the specified prefix and tags, the names of those which
+// are enabled (since the value of a disabled Secret can't be retrieved) and the content type of each matching Secret
+func filterKeyVaultSecretItems(input []keyvault.SecretItem, namePrefix string, requiredTags map[string]interface{}) ([]string, []string, map[string]interface{}, error) {
+	names := make([]string, 0)
+	enabledNames := make([]string, 0)
+	contentTypes := make(map[string]interface{})
+	for _, v := range input {
+		if v.ID == nil {
+			continue
+		}
+
+		name, err := parseNameFromSecretUrl(*v.ID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if !strings.HasPrefix(*name, namePrefix) || !keyVaultSecretItemHasTags(v, requiredTags) {
+			continue
+		}
+
+		names = append(names, *name)
+		if v.Attributes == nil || v.Attributes.Enabled == nil || *v.Attributes.Enabled {
+			enabledNames = append(enabledNames, *name)
+		}
+
+		contentType := ""
+		if v.ContentType != nil {
+			contentType = *v.ContentType
+		}
+		contentTypes[*name] = contentType
+	}
+
+	return names, enabledNames, contentTypes, nil
+}
+
+func keyVaultSecretItemHasTags(input keyvault.SecretItem, requiredTags map[string]interface{}) bool {
+	for k, v := range requiredTags {
+		value, ok := input.Tags[k]
+		if !ok || value == nil || *value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func parseNameFromSecretUrl(input string) (*string, error) {
 	uri, err := url.Parse(input)
 	if err != nil {
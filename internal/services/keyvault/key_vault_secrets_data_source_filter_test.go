@@ -0,0 +1,52 @@
+package keyvault
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFilterKeyVaultSecretItems(t *testing.T) {
+	item := func(name string, enabled *bool, tags map[string]*string) keyvault.SecretItem {
+		return keyvault.SecretItem{
+			ID: utils.String("https://example.vault.azure.net/secrets/" + name),
+			Attributes: &keyvault.SecretAttributes{
+				Enabled: enabled,
+			},
+			ContentType: utils.String("text/plain"),
+			Tags:        tags,
+		}
+	}
+	production := map[string]*string{"environment": utils.String("Production")}
+
+	items := []keyvault.SecretItem{
+		item("app-enabled", utils.Bool(true), production),
+		item("app-disabled", utils.Bool(false), production),
+		item("app-unknown", nil, production),
+		item("app-untagged", utils.Bool(true), nil),
+		item("other", utils.Bool(true), production),
+		{ID: nil},
+	}
+
+	names, enabledNames, contentTypes, err := filterKeyVaultSecretItems(items, "app-", map[string]interface{}{"environment": "Production"})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	// disabled Secrets are still returned in `names`, but their values can't be retrieved
+	expectedNames := []string{"app-enabled", "app-disabled", "app-unknown"}
+	if !reflect.DeepEqual(names, expectedNames) {
+		t.Fatalf("expected names %+v but got %+v", expectedNames, names)
+	}
+
+	expectedEnabledNames := []string{"app-enabled", "app-unknown"}
+	if !reflect.DeepEqual(enabledNames, expectedEnabledNames) {
+		t.Fatalf("expected enabled names %+v but got %+v", expectedEnabledNames, enabledNames)
+	}
+
+	if len(contentTypes) != 3 || contentTypes["app-disabled"] != "text/plain" {
+		t.Fatalf("expected content types for the 3 matching Secrets but got %+v", contentTypes)
+	}
+}
@@ -24,6 +24,24 @@ func TestAccDataSourceKeyVaultSecrets_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKeyVaultSecrets_filteredWithValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_secrets", "test")
+	r := KeyVaultSecretsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filteredWithValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("3"),
+				check.That(data.ResourceName).Key("values.%").HasValue("3"),
+				check.That(data.ResourceName).Key("values.app-1").HasValue("value-1"),
+				check.That(data.ResourceName).Key("versions.%").HasValue("3"),
+				check.That(data.ResourceName).Key("content_types.app-1").HasValue("text/plain"),
+			),
+		},
+	})
+}
+
 func (KeyVaultSecretsDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -42,3 +60,43 @@ data "azurerm_key_vault_secrets" "test" {
 }
 `, KeyVaultSecretResource{}.basic(data))
 }
+
+func (KeyVaultSecretsDataSource) filteredWithValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "app" {
+  count        = 4
+  name         = "app-${count.index}"
+  value        = "value-${count.index}"
+  content_type = "text/plain"
+  key_vault_id = azurerm_key_vault.test.id
+
+  tags = {
+    environment = count.index == 0 ? "Staging" : "Production"
+  }
+}
+
+resource "azurerm_key_vault_secret" "other" {
+  name         = "other"
+  value        = "other"
+  key_vault_id = azurerm_key_vault.test.id
+
+  tags = {
+    environment = "Production"
+  }
+}
+
+data "azurerm_key_vault_secrets" "test" {
+  key_vault_id   = azurerm_key_vault.test.id
+  name_prefix    = "app-"
+  include_values = true
+
+  required_tags = {
+    environment = "Production"
+  }
+
+  depends_on = [azurerm_key_vault_secret.test, azurerm_key_vault_secret.app, azurerm_key_vault_secret.other]
+}
+`, KeyVaultSecretResource{}.basic(data))
+}
@@ -0,0 +1,492 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKeyVaultSecrets() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultSecretsCreate,
+		Read:   resourceKeyVaultSecretsRead,
+		Update: resourceKeyVaultSecretsUpdate,
+		Delete: resourceKeyVaultSecretsDelete,
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.VaultID(id)
+			return err
+		}, keyVaultSecretsImporter),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			// TODO: Change this back to 5min, once https://github.com/hashicorp/terraform-provider-azurerm/issues/11059 is addressed.
+			Read:   pluginsdk.DefaultTimeout(30 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"secret": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				// Secrets are keyed on their name, so that changes to an existing Secret are updated in-place
+				Set: keyVaultSecretsSecretHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemName,
+						},
+
+						"value": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"content_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"expiration_date": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+
+			"versions": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			if d.HasChange("secret") {
+				return d.SetNewComputed("versions")
+			}
+			return nil
+		}),
+	}
+}
+
+func resourceKeyVaultSecretsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	secrets := keyVaultSecretsByName(d.Get("secret").(*pluginsdk.Set).List())
+	names := sortedKeyVaultSecretNames(secrets)
+	if err := checkKeyVaultSecretsDoNotExist(ctx, client, *keyVaultBaseUrl, names); err != nil {
+		return err
+	}
+
+	// the ID is set prior to writing the Secrets, so that any Secrets which were written prior to a failure are tracked
+	d.SetId(keyVaultId.ID())
+
+	recoverSoftDeleted := meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedSecrets
+	timeout := d.Timeout(pluginsdk.TimeoutCreate)
+	err = forEachNestedItemConcurrently(names, func(name string) error {
+		parameters := expandKeyVaultSecretsSetParameters(secrets[name])
+		if err := setSecretWithOptionalRecovery(ctx, client, *keyVaultBaseUrl, name, parameters, recoverSoftDeleted, timeout); err != nil {
+			return fmt.Errorf("setting Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceKeyVaultSecretsRead(d, meta)
+}
+
+func resourceKeyVaultSecretsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	oldRaw, newRaw := d.GetChange("secret")
+	oldSecrets := keyVaultSecretsByName(oldRaw.(*pluginsdk.Set).List())
+	newSecrets := keyVaultSecretsByName(newRaw.(*pluginsdk.Set).List())
+
+	toAdd := make([]string, 0)
+	toSet := make([]string, 0)
+	toUpdate := make([]string, 0)
+	toRemove := make([]string, 0)
+	for _, name := range sortedKeyVaultSecretNames(newSecrets) {
+		newSecret := newSecrets[name]
+		oldSecret, exists := oldSecrets[name]
+		switch {
+		case !exists:
+			toAdd = append(toAdd, name)
+			toSet = append(toSet, name)
+		case oldSecret["value"].(string) != newSecret["value"].(string):
+			// changing the value of the secret requires a new version
+			toSet = append(toSet, name)
+		case oldSecret["expiration_date"].(string) != "" && newSecret["expiration_date"].(string) == "":
+			// the expiration date can't be removed from an existing version, so a new version without one is created
+			toSet = append(toSet, name)
+		case oldSecret["content_type"].(string) != newSecret["content_type"].(string),
+			oldSecret["expiration_date"].(string) != newSecret["expiration_date"].(string):
+			toUpdate = append(toUpdate, name)
+		}
+	}
+	for _, name := range sortedKeyVaultSecretNames(oldSecrets) {
+		if _, exists := newSecrets[name]; !exists {
+			toRemove = append(toRemove, name)
+		}
+	}
+
+	if err := checkKeyVaultSecretsDoNotExist(ctx, client, *keyVaultBaseUrl, toAdd); err != nil {
+		return err
+	}
+
+	// Secrets which have been removed from the configuration are pruned first
+	shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeletedSecretsOnDestroy
+	if err := deleteKeyVaultSecrets(ctx, client, *keyVaultBaseUrl, toRemove, shouldPurge); err != nil {
+		return err
+	}
+
+	recoverSoftDeleted := meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedSecrets
+	timeout := d.Timeout(pluginsdk.TimeoutUpdate)
+	err = forEachNestedItemConcurrently(toSet, func(name string) error {
+		parameters := expandKeyVaultSecretsSetParameters(newSecrets[name])
+		if err := setSecretWithOptionalRecovery(ctx, client, *keyVaultBaseUrl, name, parameters, recoverSoftDeleted, timeout); err != nil {
+			return fmt.Errorf("setting Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = forEachNestedItemConcurrently(toUpdate, func(name string) error {
+		parameters := expandKeyVaultSecretsUpdateParameters(newSecrets[name])
+		if _, err := client.UpdateSecret(ctx, *keyVaultBaseUrl, name, "", parameters); err != nil {
+			return fmt.Errorf("updating Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceKeyVaultSecretsRead(d, meta)
+}
+
+func resourceKeyVaultSecretsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s exists: %v", *keyVaultId, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] %s was not found - removing from state", *keyVaultId)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	// only the Secrets which are managed by this resource are retrieved, any other Secrets within the Key Vault are ignored
+	names := sortedKeyVaultSecretNames(keyVaultSecretsByName(d.Get("secret").(*pluginsdk.Set).List()))
+
+	var mutex sync.Mutex
+	secrets := make(map[string]keyvault.SecretBundle)
+	err = forEachNestedItemConcurrently(names, func(name string) error {
+		// "" indicates the latest version
+		resp, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Secret %q was not found in Key Vault at URI %q - removing from state", name, *keyVaultBaseUrl)
+				return nil
+			}
+			return fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		secrets[name] = resp
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	output := make([]interface{}, 0)
+	versions := make(map[string]interface{})
+	for _, name := range names {
+		secret, ok := secrets[name]
+		if !ok {
+			continue
+		}
+
+		if secret.ID != nil {
+			id, err := parse.ParseNestedItemID(*secret.ID)
+			if err != nil {
+				return err
+			}
+			versions[name] = id.Version
+		}
+
+		output = append(output, flattenKeyVaultSecretsSecret(name, secret))
+	}
+
+	d.Set("key_vault_id", keyVaultId.ID())
+	if err := d.Set("secret", output); err != nil {
+		return fmt.Errorf("setting `secret`: %+v", err)
+	}
+	d.Set("versions", versions)
+
+	return nil
+}
+
+func resourceKeyVaultSecretsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s exists: %v", *keyVaultId, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] %s was not found - removing from state", *keyVaultId)
+		return nil
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	names := sortedKeyVaultSecretNames(keyVaultSecretsByName(d.Get("secret").(*pluginsdk.Set).List()))
+	shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeletedSecretsOnDestroy
+	return deleteKeyVaultSecrets(ctx, client, *keyVaultBaseUrl, names, shouldPurge)
+}
+
+// keyVaultSecretsImporter imports every Secret within the Key Vault (other than those backing a Certificate), since
+// there's no other way to determine which Secrets should be managed by this resource
+func keyVaultSecretsImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+
+	keyVaultId, err := parse.VaultID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	secretList, err := client.GetSecretsComplete(ctx, *keyVaultBaseUrl, utils.Int32(25))
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("listing Secrets within %s: %+v", *keyVaultId, err)
+	}
+
+	secrets := make([]interface{}, 0)
+	for secretList.NotDone() {
+		v := secretList.Value()
+		// Secrets backing a Certificate are managed by Key Vault and deleted along with the Certificate, so are skipped
+		if v.ID != nil && (v.Managed == nil || !*v.Managed) {
+			name, err := parseNameFromSecretUrl(*v.ID)
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, err
+			}
+
+			// the remaining fields are populated when the Secrets are read
+			secrets = append(secrets, map[string]interface{}{
+				"name":            *name,
+				"value":           "",
+				"content_type":    "",
+				"expiration_date": "",
+			})
+		}
+
+		if err := secretList.NextWithContext(ctx); err != nil {
+			return []*pluginsdk.ResourceData{d}, fmt.Errorf("listing Secrets within %s: %+v", *keyVaultId, err)
+		}
+	}
+
+	if err := d.Set("secret", secrets); err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("setting `secret`: %+v", err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func checkKeyVaultSecretsDoNotExist(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, names []string) error {
+	return forEachNestedItemConcurrently(names, func(name string) error {
+		existing, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Secret %q (Key Vault %q): %s", name, keyVaultBaseUrl, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return fmt.Errorf("a Secret named %q already exists in Key Vault %q - to be managed via Terraform this Secret needs to be removed from the Key Vault or imported into an `azurerm_key_vault_secret` resource", name, keyVaultBaseUrl)
+		}
+
+		return nil
+	})
+}
+
+func deleteKeyVaultSecrets(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, names []string, shouldPurge bool) error {
+	return forEachNestedItemConcurrently(names, func(name string) error {
+		description := fmt.Sprintf("Secret %q (Key Vault %q)", name, keyVaultBaseUrl)
+		deleter := deleteAndPurgeSecret{
+			client:      client,
+			keyVaultUri: keyVaultBaseUrl,
+			name:        name,
+		}
+		return deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter)
+	})
+}
+
+func keyVaultSecretsByName(input []interface{}) map[string]map[string]interface{} {
+	output := make(map[string]map[string]interface{})
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		output[v["name"].(string)] = v
+	}
+	return output
+}
+
+func sortedKeyVaultSecretNames(input map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func keyVaultSecretsSecretHash(v interface{}) int {
+	if m, ok := v.(map[string]interface{}); ok {
+		return pluginsdk.HashString(m["name"].(string))
+	}
+
+	return 0
+}
+
+func expandKeyVaultSecretsSetParameters(input map[string]interface{}) keyvault.SecretSetParameters {
+	return keyvault.SecretSetParameters{
+		Value:            utils.String(input["value"].(string)),
+		ContentType:      utils.String(input["content_type"].(string)),
+		SecretAttributes: expandKeyVaultSecretsSecretAttributes(input),
+	}
+}
+
+func expandKeyVaultSecretsUpdateParameters(input map[string]interface{}) keyvault.SecretUpdateParameters {
+	return keyvault.SecretUpdateParameters{
+		ContentType:      utils.String(input["content_type"].(string)),
+		SecretAttributes: expandKeyVaultSecretsSecretAttributes(input),
+	}
+}
+
+func expandKeyVaultSecretsSecretAttributes(input map[string]interface{}) *keyvault.SecretAttributes {
+	attributes := &keyvault.SecretAttributes{}
+
+	if v := input["expiration_date"].(string); v != "" {
+		expirationDate, _ := time.Parse(time.RFC3339, v) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	return attributes
+}
+
+func flattenKeyVaultSecretsSecret(name string, input keyvault.SecretBundle) map[string]interface{} {
+	value := ""
+	if input.Value != nil {
+		value = *input.Value
+	}
+
+	contentType := ""
+	if input.ContentType != nil {
+		contentType = *input.ContentType
+	}
+
+	expirationDate := ""
+	if input.Attributes != nil && input.Attributes.Expires != nil {
+		expirationDate = time.Time(*input.Attributes.Expires).Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		"name":            name,
+		"value":           value,
+		"content_type":    contentType,
+		"expiration_date": expirationDate,
+	}
+}
@@ -0,0 +1,260 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultSecretsResource struct{}
+
+func TestAccKeyVaultSecrets_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secrets", "test")
+	r := KeyVaultSecretsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret.#").HasValue("2"),
+				check.That(data.ResourceName).Key("versions.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultSecrets_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secrets", "test")
+	r := KeyVaultSecretsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret.#").HasValue("3"),
+				check.That(data.ResourceName).Key("versions.%").HasValue("3"),
+				// `second` has been removed from the configuration and so should have been pruned
+				data.CheckWithClientForResource(r.secretHasBeenPruned("second"), data.ResourceName),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret.#").HasValue("2"),
+				// the `expiration_date` of `first` has been removed from the configuration
+				data.CheckWithClientForResource(r.secretHasNoExpirationDate("first"), data.ResourceName),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultSecrets_recovery(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secrets", "test")
+	r := KeyVaultSecretsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.softDeleteRecovery(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:  r.softDeleteRecovery(data, false),
+			Destroy: true,
+		},
+		{
+			// purge true here to make sure when we end the test there's no soft-deleted items left behind
+			Config: r.softDeleteRecovery(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (KeyVaultSecretsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+	keyVaultsClient := clients.KeyVault
+
+	keyVaultId, err := parse.VaultID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return nil, fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	for key := range state.Attributes {
+		if !strings.HasPrefix(key, "versions.") || key == "versions.%" {
+			continue
+		}
+
+		name := strings.TrimPrefix(key, "versions.")
+		resp, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (KeyVaultSecretsResource) secretHasBeenPruned(name string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		keyVaultId, err := parse.VaultID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		keyVaultBaseUrl, err := clients.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+		if err != nil {
+			return fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+		}
+
+		resp, err := clients.KeyVault.ManagementClient.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+
+		return fmt.Errorf("expected Secret %q (Key Vault %q) to have been removed but it still exists", name, *keyVaultBaseUrl)
+	}
+}
+
+func (KeyVaultSecretsResource) secretHasNoExpirationDate(name string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		keyVaultId, err := parse.VaultID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		keyVaultBaseUrl, err := clients.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+		if err != nil {
+			return fmt.Errorf("looking up vault url from id %q: %+v", *keyVaultId, err)
+		}
+
+		resp, err := clients.KeyVault.ManagementClient.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+
+		if resp.Attributes != nil && resp.Attributes.Expires != nil {
+			return fmt.Errorf("expected Secret %q (Key Vault %q) to have no expiration date but got %s", name, *keyVaultBaseUrl, time.Time(*resp.Attributes.Expires).Format(time.RFC3339))
+		}
+
+		return nil
+	}
+}
+
+func (r KeyVaultSecretsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secrets" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+
+  secret {
+    name  = "first"
+    value = "rick-and-morty"
+  }
+
+  secret {
+    name         = "second"
+    value        = "szechuan"
+    content_type = "text/plain"
+  }
+}
+`, KeyVaultSecretResource{}.template(data))
+}
+
+func (r KeyVaultSecretsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secrets" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+
+  secret {
+    name            = "first"
+    value           = "rick-and-morty"
+    content_type    = "text/plain"
+    expiration_date = "2030-12-31T00:00:00Z"
+  }
+
+  secret {
+    name  = "third"
+    value = "pickle-rick"
+  }
+
+  secret {
+    name  = "fourth"
+    value = "mr-meeseeks"
+  }
+}
+`, KeyVaultSecretResource{}.template(data))
+}
+
+func (r KeyVaultSecretsResource) softDeleteRecovery(data acceptance.TestData, purge bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_deleted_secrets_on_destroy = "%t"
+      recover_soft_deleted_secrets          = true
+    }
+  }
+}
+
+%s
+
+resource "azurerm_key_vault_secrets" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+
+  secret {
+    name  = "first"
+    value = "rick-and-morty"
+  }
+
+  secret {
+    name  = "second"
+    value = "szechuan"
+  }
+}
+`, purge, KeyVaultSecretResource{}.template(data))
+}
@@ -50,6 +50,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_key_vault_key":                                          resourceKeyVaultKey(),
 		"azurerm_key_vault_managed_hardware_security_module":             resourceKeyVaultManagedHardwareSecurityModule(),
 		"azurerm_key_vault_secret":                                       resourceKeyVaultSecret(),
+		"azurerm_key_vault_secrets":                                      resourceKeyVaultSecrets(),
 		"azurerm_key_vault":                                              resourceKeyVault(),
 		"azurerm_key_vault_managed_storage_account":                      resourceKeyVaultManagedStorageAccount(),
 		"azurerm_key_vault_managed_storage_account_sas_token_definition": resourceKeyVaultManagedStorageAccountSasTokenDefinition(),
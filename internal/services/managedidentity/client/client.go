@@ -0,0 +1,21 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity/sdk/2022-01-31-preview/managedidentities"
+)
+
+// FederatedCredentialsClient exposes the Federated Identity Credentials API, which isn't available in the
+// API version used by the generated Managed Identity client
+type FederatedCredentialsClient struct {
+	ManagedIdentitiesClient *managedidentities.ManagedIdentitiesClient
+}
+
+func NewFederatedCredentialsClient(o *common.ClientOptions) *FederatedCredentialsClient {
+	managedIdentitiesClient := managedidentities.NewManagedIdentitiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedIdentitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &FederatedCredentialsClient{
+		ManagedIdentitiesClient: &managedIdentitiesClient,
+	}
+}
@@ -0,0 +1,233 @@
+package managedidentity
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity/sdk/2022-01-31-preview/managedidentities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = FederatedIdentityCredentialResource{}
+
+type FederatedIdentityCredentialResource struct{}
+
+type FederatedIdentityCredentialResourceSchema struct {
+	Name              string   `tfschema:"name"`
+	ResourceGroupName string   `tfschema:"resource_group_name"`
+	ParentId          string   `tfschema:"parent_id"`
+	Audience          []string `tfschema:"audience"`
+	Issuer            string   `tfschema:"issuer"`
+	Subject           string   `tfschema:"subject"`
+}
+
+func (r FederatedIdentityCredentialResource) ModelObject() interface{} {
+	return &FederatedIdentityCredentialResourceSchema{}
+}
+
+func (r FederatedIdentityCredentialResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedidentities.ValidateFederatedIdentityCredentialID
+}
+
+func (r FederatedIdentityCredentialResource) ResourceType() string {
+	return "azurerm_federated_identity_credential"
+}
+
+func (r FederatedIdentityCredentialResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_]{2,119}$`),
+				"`name` must be between 3 and 120 characters, start with an alphanumeric character and may only contain alphanumeric characters, hyphens and underscores",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"parent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+
+		"audience": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			// the API currently only supports a single audience
+			MaxItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"issuer": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"subject": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FederatedIdentityCredentialResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.FederatedCredentials.ManagedIdentitiesClient
+
+			var config FederatedIdentityCredentialResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parentId, err := commonids.ParseUserAssignedIdentityID(config.ParentId)
+			if err != nil {
+				return err
+			}
+
+			if !strings.EqualFold(parentId.ResourceGroupName, config.ResourceGroupName) {
+				return fmt.Errorf("`resource_group_name` must match the Resource Group of the User Assigned Identity specified in `parent_id` (%q)", parentId.ResourceGroupName)
+			}
+
+			id := managedidentities.NewFederatedIdentityCredentialID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.ResourceName, config.Name)
+
+			existing, err := client.FederatedIdentityCredentialsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := managedidentities.FederatedIdentityCredential{
+				Properties: &managedidentities.FederatedIdentityCredentialProperties{
+					Audiences: config.Audience,
+					Issuer:    config.Issuer,
+					Subject:   config.Subject,
+				},
+			}
+
+			if _, err := client.FederatedIdentityCredentialsCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.FederatedCredentials.ManagedIdentitiesClient
+
+			id, err := managedidentities.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.FederatedIdentityCredentialsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			schema := FederatedIdentityCredentialResourceSchema{
+				Name:              id.FederatedIdentityCredentialResourceName,
+				ResourceGroupName: id.ResourceGroupName,
+				ParentId:          commonids.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					schema.Audience = props.Audiences
+					schema.Issuer = props.Issuer
+					schema.Subject = props.Subject
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.FederatedCredentials.ManagedIdentitiesClient
+
+			id, err := managedidentities.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config FederatedIdentityCredentialResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := managedidentities.FederatedIdentityCredential{
+				Properties: &managedidentities.FederatedIdentityCredentialProperties{
+					Audiences: config.Audience,
+					Issuer:    config.Issuer,
+					Subject:   config.Subject,
+				},
+			}
+
+			if _, err := client.FederatedIdentityCredentialsCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.FederatedCredentials.ManagedIdentitiesClient
+
+			id, err := managedidentities.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.FederatedIdentityCredentialsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package managedidentities
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ManagedIdentitiesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedIdentitiesClientWithBaseURI(endpoint string) ManagedIdentitiesClient {
+	return ManagedIdentitiesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package managedidentities
+
+type FederatedIdentityCredential struct {
+	Id         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties *FederatedIdentityCredentialProperties `json:"properties,omitempty"`
+	SystemData *SystemData                            `json:"systemData,omitempty"`
+	Type       *string                                `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package managedidentities
+
+import "fmt"
+
+const defaultApiVersion = "2022-01-31-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/managedidentities/%s", defaultApiVersion)
+}
@@ -0,0 +1,195 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendHTTPSettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendHTTPSettingsCreate,
+		Read:   resourceApplicationGatewayBackendHTTPSettingsRead,
+		Update: resourceApplicationGatewayBackendHTTPSettingsUpdate,
+		Delete: resourceApplicationGatewayBackendHTTPSettingsDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendHttpSettingsCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildSchema("backend_http_settings"),
+	}
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendHttpSettingsCollectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	if err := validateApplicationGatewayBackendHTTPSettingsHostName(d); err != nil {
+		return err
+	}
+	setting := expandApplicationGatewayBackendHTTPSetting(applicationGatewayChildConfig(d, "backend_http_settings"), gatewayId.ID())
+
+	err = updateApplicationGatewayChild(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		if props.BackendHTTPSettingsCollection != nil {
+			settings = *props.BackendHTTPSettingsCollection
+		}
+
+		for _, v := range settings {
+			if utils.NormalizeNilableString(v.Name) == id.BackendHttpSettingsCollectionName {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_http_settings", id.ID())
+			}
+		}
+
+		settings = append(settings, setting)
+		props.BackendHTTPSettingsCollection = &settings
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	applicationGateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var setting *network.ApplicationGatewayBackendHTTPSettings
+	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, v := range *props.BackendHTTPSettingsCollection {
+			if utils.NormalizeNilableString(v.Name) == id.BackendHttpSettingsCollectionName {
+				v := v
+				setting = &v
+				break
+			}
+		}
+	}
+	if setting == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	flattened, err := flattenApplicationGatewayBackendHTTPSetting(*setting)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return applicationGatewayChildSetAttributes(d, "backend_http_settings", flattened)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	if err := validateApplicationGatewayBackendHTTPSettingsHostName(d); err != nil {
+		return err
+	}
+	setting := expandApplicationGatewayBackendHTTPSetting(applicationGatewayChildConfig(d, "backend_http_settings"), gatewayId.ID())
+
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.BackendHTTPSettingsCollection != nil {
+			for i, v := range *props.BackendHTTPSettingsCollection {
+				if utils.NormalizeNilableString(v.Name) == id.BackendHttpSettingsCollectionName {
+					(*props.BackendHTTPSettingsCollection)[i] = setting
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		if props.BackendHTTPSettingsCollection != nil {
+			for _, v := range *props.BackendHTTPSettingsCollection {
+				if utils.NormalizeNilableString(v.Name) != id.BackendHttpSettingsCollectionName {
+					settings = append(settings, v)
+				}
+			}
+		}
+		props.BackendHTTPSettingsCollection = &settings
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func validateApplicationGatewayBackendHTTPSettingsHostName(d *pluginsdk.ResourceData) error {
+	if d.Get("host_name").(string) != "" && d.Get("pick_host_name_from_backend_address").(bool) {
+		return fmt.Errorf("Only one of `host_name` or `pick_host_name_from_backend_address` can be set")
+	}
+
+	return nil
+}
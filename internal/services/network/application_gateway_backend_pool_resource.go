@@ -0,0 +1,176 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendPoolCreate,
+		Read:   resourceApplicationGatewayBackendPoolRead,
+		Update: resourceApplicationGatewayBackendPoolUpdate,
+		Delete: resourceApplicationGatewayBackendPoolDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendAddressPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildSchema("backend_address_pool"),
+	}
+}
+
+func resourceApplicationGatewayBackendPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	pool := expandApplicationGatewayBackendAddressPool(applicationGatewayChildConfig(d, "backend_address_pool"))
+
+	err = updateApplicationGatewayChild(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			pools = *props.BackendAddressPools
+		}
+
+		for _, v := range pools {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_pool", id.ID())
+			}
+		}
+
+		pools = append(pools, pool)
+		props.BackendAddressPools = &pools
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayBackendPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	applicationGateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var pool *network.ApplicationGatewayBackendAddressPool
+	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, v := range *props.BackendAddressPools {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				v := v
+				pool = &v
+				break
+			}
+		}
+	}
+	if pool == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	return applicationGatewayChildSetAttributes(d, "backend_address_pool", flattenApplicationGatewayBackendAddressPool(*pool))
+}
+
+func resourceApplicationGatewayBackendPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	pool := expandApplicationGatewayBackendAddressPool(applicationGatewayChildConfig(d, "backend_address_pool"))
+
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.BackendAddressPools != nil {
+			for i, v := range *props.BackendAddressPools {
+				if utils.NormalizeNilableString(v.Name) == id.Name {
+					(*props.BackendAddressPools)[i] = pool
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayBackendPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			for _, v := range *props.BackendAddressPools {
+				if utils.NormalizeNilableString(v.Name) != id.Name {
+					pools = append(pools, v)
+				}
+			}
+		}
+		props.BackendAddressPools = &pools
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
+// applicationGatewayChildSchema returns the schema for a resource managing a single item within the specified block
+// of an Application Gateway, so that the child resources remain consistent with the Application Gateway resource
+func applicationGatewayChildSchema(block string) map[string]*pluginsdk.Schema {
+	out := map[string]*pluginsdk.Schema{
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationGatewayID,
+		},
+	}
+
+	for k, v := range resourceApplicationGateway().Schema[block].Elem.(*pluginsdk.Resource).Schema {
+		// the ID of the item is the ID of the resource
+		if k == "id" {
+			continue
+		}
+		out[k] = v
+	}
+
+	name := *out["name"]
+	name.ForceNew = true
+	name.ValidateFunc = validation.StringIsNotEmpty
+	out["name"] = &name
+
+	return out
+}
+
+// applicationGatewayChildConfig returns the configuration of a child resource in the same format as an item within the
+// specified block of the Application Gateway, so that it can be expanded using the same functions
+func applicationGatewayChildConfig(d *pluginsdk.ResourceData, block string) map[string]interface{} {
+	config := make(map[string]interface{})
+	for k := range applicationGatewayChildSchema(block) {
+		config[k] = d.Get(k)
+	}
+	return config
+}
+
+func applicationGatewayChildSetAttributes(d *pluginsdk.ResourceData, block string, flattened map[string]interface{}) error {
+	for k := range applicationGatewayChildSchema(block) {
+		if k == "application_gateway_id" {
+			continue
+		}
+
+		if err := d.Set(k, flattened[k]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+	return nil
+}
+
+// updateApplicationGatewayChild retrieves the Application Gateway, applies the update function to it and then updates the
+// Application Gateway, holding the lock on the Application Gateway throughout so that child resources don't conflict
+func updateApplicationGatewayChild(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, update func(props *network.ApplicationGatewayPropertiesFormat) error) error {
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
+	applicationGateway, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if err := update(applicationGateway.ApplicationGatewayPropertiesFormat); err != nil {
+		return err
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// applicationGatewayChildNames returns the names of the items within a block of the Application Gateway
+func applicationGatewayChildNames(input interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+	set, ok := input.(*pluginsdk.Set)
+	if !ok || set == nil {
+		return names
+	}
+
+	for _, raw := range set.List() {
+		if v, ok := raw.(map[string]interface{}); ok {
+			names[v["name"].(string)] = struct{}{}
+		}
+	}
+	return names
+}
+
+// applicationGatewayManagedChildNames returns the names of the items within a block which are (or until this apply were)
+// managed by the Application Gateway resource, any other items are managed by the child resources
+func applicationGatewayManagedChildNames(d *pluginsdk.ResourceData, block string) map[string]struct{} {
+	old, new := d.GetChange(block)
+	names := applicationGatewayChildNames(old)
+	for name := range applicationGatewayChildNames(new) {
+		names[name] = struct{}{}
+	}
+	return names
+}
+
+// preserveApplicationGatewayExternallyManagedChildren re-adds the items managed by the child resources to any blocks which
+// are being updated, since these aren't part of the configuration of the Application Gateway resource
+func preserveApplicationGatewayExternallyManagedChildren(d *pluginsdk.ResourceData, existing network.ApplicationGatewayPropertiesFormat, props *network.ApplicationGatewayPropertiesFormat) {
+	if d.HasChange("backend_address_pool") && existing.BackendAddressPools != nil && props.BackendAddressPools != nil {
+		managed := applicationGatewayManagedChildNames(d, "backend_address_pool")
+		for _, v := range *existing.BackendAddressPools {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; !ok {
+				*props.BackendAddressPools = append(*props.BackendAddressPools, v)
+			}
+		}
+	}
+
+	if d.HasChange("backend_http_settings") && existing.BackendHTTPSettingsCollection != nil && props.BackendHTTPSettingsCollection != nil {
+		managed := applicationGatewayManagedChildNames(d, "backend_http_settings")
+		for _, v := range *existing.BackendHTTPSettingsCollection {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; !ok {
+				*props.BackendHTTPSettingsCollection = append(*props.BackendHTTPSettingsCollection, v)
+			}
+		}
+	}
+
+	if d.HasChange("http_listener") && existing.HTTPListeners != nil && props.HTTPListeners != nil {
+		managed := applicationGatewayManagedChildNames(d, "http_listener")
+		for _, v := range *existing.HTTPListeners {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; !ok {
+				*props.HTTPListeners = append(*props.HTTPListeners, v)
+			}
+		}
+	}
+
+	if d.HasChange("probe") && existing.Probes != nil && props.Probes != nil {
+		managed := applicationGatewayManagedChildNames(d, "probe")
+		for _, v := range *existing.Probes {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; !ok {
+				*props.Probes = append(*props.Probes, v)
+			}
+		}
+	}
+
+	if d.HasChange("request_routing_rule") && existing.RequestRoutingRules != nil && props.RequestRoutingRules != nil {
+		managed := applicationGatewayManagedChildNames(d, "request_routing_rule")
+		for _, v := range *existing.RequestRoutingRules {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; !ok {
+				*props.RequestRoutingRules = append(*props.RequestRoutingRules, v)
+			}
+		}
+	}
+}
+
+// removeApplicationGatewayExternallyManagedChildren removes the items managed by the child resources from the Application
+// Gateway, so that these aren't tracked by the Application Gateway resource
+func removeApplicationGatewayExternallyManagedChildren(d *pluginsdk.ResourceData, props *network.ApplicationGatewayPropertiesFormat) {
+	if props.BackendAddressPools != nil {
+		managed := applicationGatewayChildNames(d.Get("backend_address_pool"))
+		filtered := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		for _, v := range *props.BackendAddressPools {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; ok {
+				filtered = append(filtered, v)
+			}
+		}
+		props.BackendAddressPools = &filtered
+	}
+
+	if props.BackendHTTPSettingsCollection != nil {
+		managed := applicationGatewayChildNames(d.Get("backend_http_settings"))
+		filtered := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		for _, v := range *props.BackendHTTPSettingsCollection {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; ok {
+				filtered = append(filtered, v)
+			}
+		}
+		props.BackendHTTPSettingsCollection = &filtered
+	}
+
+	if props.HTTPListeners != nil {
+		managed := applicationGatewayChildNames(d.Get("http_listener"))
+		filtered := make([]network.ApplicationGatewayHTTPListener, 0)
+		for _, v := range *props.HTTPListeners {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; ok {
+				filtered = append(filtered, v)
+			}
+		}
+		props.HTTPListeners = &filtered
+	}
+
+	if props.Probes != nil {
+		managed := applicationGatewayChildNames(d.Get("probe"))
+		filtered := make([]network.ApplicationGatewayProbe, 0)
+		for _, v := range *props.Probes {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; ok {
+				filtered = append(filtered, v)
+			}
+		}
+		props.Probes = &filtered
+	}
+
+	if props.RequestRoutingRules != nil {
+		managed := applicationGatewayChildNames(d.Get("request_routing_rule"))
+		filtered := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		for _, v := range *props.RequestRoutingRules {
+			if _, ok := managed[utils.NormalizeNilableString(v.Name)]; ok {
+				filtered = append(filtered, v)
+			}
+		}
+		props.RequestRoutingRules = &filtered
+	}
+}
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayListenerCreate,
+		Read:   resourceApplicationGatewayListenerRead,
+		Update: resourceApplicationGatewayListenerUpdate,
+		Delete: resourceApplicationGatewayListenerDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HttpListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildSchema("http_listener"),
+	}
+}
+
+func resourceApplicationGatewayListenerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHttpListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	listener, err := expandApplicationGatewayHTTPListener(applicationGatewayChildConfig(d, "http_listener"), gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	err = updateApplicationGatewayChild(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+		if props.HTTPListeners != nil {
+			listeners = *props.HTTPListeners
+		}
+
+		for _, v := range listeners {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				return tf.ImportAsExistsError("azurerm_application_gateway_listener", id.ID())
+			}
+		}
+
+		listeners = append(listeners, *listener)
+		props.HTTPListeners = &listeners
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	applicationGateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var listener *network.ApplicationGatewayHTTPListener
+	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil && props.HTTPListeners != nil {
+		for _, v := range *props.HTTPListeners {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				v := v
+				listener = &v
+				break
+			}
+		}
+	}
+	if listener == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	flattened, err := flattenApplicationGatewayHTTPListener(*listener)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return applicationGatewayChildSetAttributes(d, "http_listener", flattened)
+}
+
+func resourceApplicationGatewayListenerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	listener, err := expandApplicationGatewayHTTPListener(applicationGatewayChildConfig(d, "http_listener"), gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", *id, err)
+	}
+
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.HTTPListeners != nil {
+			for i, v := range *props.HTTPListeners {
+				if utils.NormalizeNilableString(v.Name) == id.Name {
+					(*props.HTTPListeners)[i] = *listener
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+		if props.HTTPListeners != nil {
+			for _, v := range *props.HTTPListeners {
+				if utils.NormalizeNilableString(v.Name) != id.Name {
+					listeners = append(listeners, v)
+				}
+			}
+		}
+		props.HTTPListeners = &listeners
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
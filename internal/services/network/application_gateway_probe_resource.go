@@ -0,0 +1,197 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProbeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildSchema("probe"),
+	}
+}
+
+func resourceApplicationGatewayProbeCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	if err := validateApplicationGatewayProbeHost(d); err != nil {
+		return err
+	}
+	probe := expandApplicationGatewayProbe(applicationGatewayChildConfig(d, "probe"))
+
+	err = updateApplicationGatewayChild(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			probes = *props.Probes
+		}
+
+		for _, v := range probes {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+			}
+		}
+
+		probes = append(probes, probe)
+		props.Probes = &probes
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	applicationGateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var probe *network.ApplicationGatewayProbe
+	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil && props.Probes != nil {
+		for _, v := range *props.Probes {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				v := v
+				probe = &v
+				break
+			}
+		}
+	}
+	if probe == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	return applicationGatewayChildSetAttributes(d, "probe", flattenApplicationGatewayProbe(*probe))
+}
+
+func resourceApplicationGatewayProbeUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	if err := validateApplicationGatewayProbeHost(d); err != nil {
+		return err
+	}
+	probe := expandApplicationGatewayProbe(applicationGatewayChildConfig(d, "probe"))
+
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.Probes != nil {
+			for i, v := range *props.Probes {
+				if utils.NormalizeNilableString(v.Name) == id.Name {
+					(*props.Probes)[i] = probe
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			for _, v := range *props.Probes {
+				if utils.NormalizeNilableString(v.Name) != id.Name {
+					probes = append(probes, v)
+				}
+			}
+		}
+		props.Probes = &probes
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func validateApplicationGatewayProbeHost(d *pluginsdk.ResourceData) error {
+	host := d.Get("host").(string)
+	pickHostNameFromBackendHTTPSettings := d.Get("pick_host_name_from_backend_http_settings").(bool)
+
+	if host == "" && !pickHostNameFromBackendHTTPSettings {
+		return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
+	}
+
+	if host != "" && pickHostNameFromBackendHTTPSettings {
+		return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
+	}
+
+	return nil
+}
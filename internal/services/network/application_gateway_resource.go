@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -913,6 +914,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"ignore_externally_managed_children": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			//lintignore:S016,S023
 			"probe": {
 				Type:     pluginsdk.TypeSet,
@@ -1757,6 +1764,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	applicationGateway, err := client.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1769,6 +1779,7 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
 		applicationGateway.ApplicationGatewayPropertiesFormat = &network.ApplicationGatewayPropertiesFormat{}
 	}
+	existing := *applicationGateway.ApplicationGatewayPropertiesFormat
 
 	if d.HasChange("enable_http2") {
 		applicationGateway.ApplicationGatewayPropertiesFormat.EnableHTTP2 = utils.Bool(d.Get("enable_http2").(bool))
@@ -1930,6 +1941,10 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		applicationGateway.Identity = expandedIdentity
 	}
 
+	if d.Get("ignore_externally_managed_children").(bool) {
+		preserveApplicationGatewayExternallyManagedChildren(d, existing, applicationGateway.ApplicationGatewayPropertiesFormat)
+	}
+
 	// validation (todo these should probably be moved into their respective expand functions, which would then return an error?)
 	if applicationGateway.ApplicationGatewayPropertiesFormat != nil && applicationGateway.ApplicationGatewayPropertiesFormat.BackendHTTPSettingsCollection != nil {
 		for _, backendHttpSettings := range *applicationGateway.ApplicationGatewayPropertiesFormat.BackendHTTPSettingsCollection {
@@ -2051,6 +2066,10 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil {
+		if d.Get("ignore_externally_managed_children").(bool) {
+			removeApplicationGatewayExternallyManagedChildren(d, props)
+		}
+
 		if err = d.Set("authentication_certificate", flattenApplicationGatewayAuthenticationCertificates(props.AuthenticationCertificates, d)); err != nil {
 			return fmt.Errorf("setting `authentication_certificate`: %+v", err)
 		}
@@ -2198,6 +2217,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -2395,40 +2417,41 @@ func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]n
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-		backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
-
-		if fqdnsConfig, ok := v["fqdns"]; ok {
-			fqdns := fqdnsConfig.(*schema.Set).List()
-			for _, ip := range fqdns {
-				backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-					Fqdn: utils.String(ip.(string)),
-				})
-			}
-		}
+		results = append(results, expandApplicationGatewayBackendAddressPool(raw.(map[string]interface{})))
+	}
 
-		if ipAddressesConfig, ok := v["ip_addresses"]; ok {
-			ipAddresses := ipAddressesConfig.(*schema.Set).List()
+	return &results
+}
 
-			for _, ip := range ipAddresses {
-				backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-					IPAddress: utils.String(ip.(string)),
-				})
-			}
-		}
+func expandApplicationGatewayBackendAddressPool(v map[string]interface{}) network.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
 
-		name := v["name"].(string)
-		output := network.ApplicationGatewayBackendAddressPool{
-			Name: utils.String(name),
-			ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
-				BackendAddresses: &backendAddresses,
-			},
+	if fqdnsConfig, ok := v["fqdns"]; ok {
+		fqdns := fqdnsConfig.(*schema.Set).List()
+		for _, ip := range fqdns {
+			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+				Fqdn: utils.String(ip.(string)),
+			})
 		}
+	}
 
-		results = append(results, output)
+	if ipAddressesConfig, ok := v["ip_addresses"]; ok {
+		ipAddresses := ipAddressesConfig.(*schema.Set).List()
+
+		for _, ip := range ipAddresses {
+			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+				IPAddress: utils.String(ip.(string)),
+			})
+		}
 	}
 
-	return &results
+	name := v["name"].(string)
+	return network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(name),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
 }
 
 func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGatewayBackendAddressPool) []interface{} {
@@ -2438,38 +2461,42 @@ func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		ipAddressList := make([]interface{}, 0)
-		fqdnList := make([]interface{}, 0)
-
-		if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
-			if props.BackendAddresses != nil {
-				for _, address := range *props.BackendAddresses {
-					if address.IPAddress != nil {
-						ipAddressList = append(ipAddressList, *address.IPAddress)
-					} else if address.Fqdn != nil {
-						fqdnList = append(fqdnList, *address.Fqdn)
-					}
+		results = append(results, flattenApplicationGatewayBackendAddressPool(config))
+	}
+
+	return results
+}
+
+func flattenApplicationGatewayBackendAddressPool(config network.ApplicationGatewayBackendAddressPool) map[string]interface{} {
+	ipAddressList := make([]interface{}, 0)
+	fqdnList := make([]interface{}, 0)
+
+	if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
+		if props.BackendAddresses != nil {
+			for _, address := range *props.BackendAddresses {
+				if address.IPAddress != nil {
+					ipAddressList = append(ipAddressList, *address.IPAddress)
+				} else if address.Fqdn != nil {
+					fqdnList = append(fqdnList, *address.Fqdn)
 				}
 			}
 		}
+	}
 
-		output := map[string]interface{}{
-			"fqdns":        fqdnList,
-			"ip_addresses": ipAddressList,
-		}
-
-		if config.ID != nil {
-			output["id"] = *config.ID
-		}
+	output := map[string]interface{}{
+		"fqdns":        fqdnList,
+		"ip_addresses": ipAddressList,
+	}
 
-		if config.Name != nil {
-			output["name"] = *config.Name
-		}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-		results = append(results, output)
+	if config.Name != nil {
+		output["name"] = *config.Name
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
@@ -2477,86 +2504,88 @@ func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gate
 	vs := d.Get("backend_http_settings").(*schema.Set).List()
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		results = append(results, expandApplicationGatewayBackendHTTPSetting(raw.(map[string]interface{}), gatewayID))
+	}
 
-		name := v["name"].(string)
-		path := v["path"].(string)
-		port := int32(v["port"].(int))
-		protocol := v["protocol"].(string)
-		cookieBasedAffinity := v["cookie_based_affinity"].(string)
-		pickHostNameFromBackendAddress := v["pick_host_name_from_backend_address"].(bool)
-		requestTimeout := int32(v["request_timeout"].(int))
-
-		setting := network.ApplicationGatewayBackendHTTPSettings{
-			Name: &name,
-			ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
-				CookieBasedAffinity:            network.ApplicationGatewayCookieBasedAffinity(cookieBasedAffinity),
-				Path:                           utils.String(path),
-				PickHostNameFromBackendAddress: utils.Bool(pickHostNameFromBackendAddress),
-				Port:                           utils.Int32(port),
-				Protocol:                       network.ApplicationGatewayProtocol(protocol),
-				RequestTimeout:                 utils.Int32(requestTimeout),
-				ConnectionDraining:             expandApplicationGatewayConnectionDraining(v),
-			},
-		}
+	return &results
+}
 
-		hostName := v["host_name"].(string)
-		if hostName != "" {
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.HostName = utils.String(hostName)
-		}
+func expandApplicationGatewayBackendHTTPSetting(v map[string]interface{}, gatewayID string) network.ApplicationGatewayBackendHTTPSettings {
+	name := v["name"].(string)
+	path := v["path"].(string)
+	port := int32(v["port"].(int))
+	protocol := v["protocol"].(string)
+	cookieBasedAffinity := v["cookie_based_affinity"].(string)
+	pickHostNameFromBackendAddress := v["pick_host_name_from_backend_address"].(bool)
+	requestTimeout := int32(v["request_timeout"].(int))
+
+	setting := network.ApplicationGatewayBackendHTTPSettings{
+		Name: &name,
+		ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+			CookieBasedAffinity:            network.ApplicationGatewayCookieBasedAffinity(cookieBasedAffinity),
+			Path:                           utils.String(path),
+			PickHostNameFromBackendAddress: utils.Bool(pickHostNameFromBackendAddress),
+			Port:                           utils.Int32(port),
+			Protocol:                       network.ApplicationGatewayProtocol(protocol),
+			RequestTimeout:                 utils.Int32(requestTimeout),
+			ConnectionDraining:             expandApplicationGatewayConnectionDraining(v),
+		},
+	}
 
-		affinityCookieName := v["affinity_cookie_name"].(string)
-		if affinityCookieName != "" {
-			setting.AffinityCookieName = utils.String(affinityCookieName)
-		}
+	hostName := v["host_name"].(string)
+	if hostName != "" {
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.HostName = utils.String(hostName)
+	}
 
-		if v["authentication_certificate"] != nil {
-			authCerts := v["authentication_certificate"].([]interface{})
-			authCertSubResources := make([]network.SubResource, 0)
+	affinityCookieName := v["affinity_cookie_name"].(string)
+	if affinityCookieName != "" {
+		setting.AffinityCookieName = utils.String(affinityCookieName)
+	}
 
-			for _, rawAuthCert := range authCerts {
-				authCert := rawAuthCert.(map[string]interface{})
-				authCertName := authCert["name"].(string)
-				authCertID := fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, authCertName)
-				authCertSubResource := network.SubResource{
-					ID: utils.String(authCertID),
-				}
+	if v["authentication_certificate"] != nil {
+		authCerts := v["authentication_certificate"].([]interface{})
+		authCertSubResources := make([]network.SubResource, 0)
 
-				authCertSubResources = append(authCertSubResources, authCertSubResource)
+		for _, rawAuthCert := range authCerts {
+			authCert := rawAuthCert.(map[string]interface{})
+			authCertName := authCert["name"].(string)
+			authCertID := fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, authCertName)
+			authCertSubResource := network.SubResource{
+				ID: utils.String(authCertID),
 			}
 
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
+			authCertSubResources = append(authCertSubResources, authCertSubResource)
 		}
 
-		if v["trusted_root_certificate_names"] != nil {
-			trustedRootCertNames := v["trusted_root_certificate_names"].([]interface{})
-			trustedRootCertSubResources := make([]network.SubResource, 0)
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
+	}
 
-			for _, rawTrustedRootCertName := range trustedRootCertNames {
-				trustedRootCertName := rawTrustedRootCertName.(string)
-				trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertName)
-				trustedRootCertSubResource := network.SubResource{
-					ID: utils.String(trustedRootCertID),
-				}
+	if v["trusted_root_certificate_names"] != nil {
+		trustedRootCertNames := v["trusted_root_certificate_names"].([]interface{})
+		trustedRootCertSubResources := make([]network.SubResource, 0)
 
-				trustedRootCertSubResources = append(trustedRootCertSubResources, trustedRootCertSubResource)
+		for _, rawTrustedRootCertName := range trustedRootCertNames {
+			trustedRootCertName := rawTrustedRootCertName.(string)
+			trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertName)
+			trustedRootCertSubResource := network.SubResource{
+				ID: utils.String(trustedRootCertID),
 			}
 
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.TrustedRootCertificates = &trustedRootCertSubResources
+			trustedRootCertSubResources = append(trustedRootCertSubResources, trustedRootCertSubResource)
 		}
 
-		probeName := v["probe_name"].(string)
-		if probeName != "" {
-			probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.Probe = &network.SubResource{
-				ID: utils.String(probeID),
-			}
-		}
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.TrustedRootCertificates = &trustedRootCertSubResources
+	}
 
-		results = append(results, setting)
+	probeName := v["probe_name"].(string)
+	if probeName != "" {
+		probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.Probe = &network.SubResource{
+			ID: utils.String(probeID),
+		}
 	}
 
-	return &results
+	return setting
 }
 
 func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGatewayBackendHTTPSettings) ([]interface{}, error) {
@@ -2566,101 +2595,110 @@ func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGa
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
-
-		if v.ID != nil {
-			output["id"] = *v.ID
+		output, err := flattenApplicationGatewayBackendHTTPSetting(v)
+		if err != nil {
+			return nil, err
 		}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+		results = append(results, output)
+	}
 
-		if props := v.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
-			output["cookie_based_affinity"] = string(props.CookieBasedAffinity)
+	return results, nil
+}
 
-			if affinityCookieName := props.AffinityCookieName; affinityCookieName != nil {
-				output["affinity_cookie_name"] = affinityCookieName
-			}
+func flattenApplicationGatewayBackendHTTPSetting(v network.ApplicationGatewayBackendHTTPSettings) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
 
-			if path := props.Path; path != nil {
-				output["path"] = *path
-			}
-			output["connection_draining"] = flattenApplicationGatewayConnectionDraining(props.ConnectionDraining)
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
 
-			if port := props.Port; port != nil {
-				output["port"] = int(*port)
-			}
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
 
-			if hostName := props.HostName; hostName != nil {
-				output["host_name"] = *hostName
-			}
+	if props := v.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
+		output["cookie_based_affinity"] = string(props.CookieBasedAffinity)
 
-			if pickHostNameFromBackendAddress := props.PickHostNameFromBackendAddress; pickHostNameFromBackendAddress != nil {
-				output["pick_host_name_from_backend_address"] = *pickHostNameFromBackendAddress
-			}
+		if affinityCookieName := props.AffinityCookieName; affinityCookieName != nil {
+			output["affinity_cookie_name"] = affinityCookieName
+		}
 
-			output["protocol"] = string(props.Protocol)
+		if path := props.Path; path != nil {
+			output["path"] = *path
+		}
+		output["connection_draining"] = flattenApplicationGatewayConnectionDraining(props.ConnectionDraining)
 
-			if timeout := props.RequestTimeout; timeout != nil {
-				output["request_timeout"] = int(*timeout)
-			}
+		if port := props.Port; port != nil {
+			output["port"] = int(*port)
+		}
 
-			authenticationCertificates := make([]interface{}, 0)
-			if certs := props.AuthenticationCertificates; certs != nil {
-				for _, cert := range *certs {
-					if cert.ID == nil {
-						continue
-					}
+		if hostName := props.HostName; hostName != nil {
+			output["host_name"] = *hostName
+		}
 
-					certId, err := parse.AuthenticationCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		if pickHostNameFromBackendAddress := props.PickHostNameFromBackendAddress; pickHostNameFromBackendAddress != nil {
+			output["pick_host_name_from_backend_address"] = *pickHostNameFromBackendAddress
+		}
 
-					certificate := map[string]interface{}{
-						"id":   certId.ID(),
-						"name": certId.Name,
-					}
-					authenticationCertificates = append(authenticationCertificates, certificate)
-				}
-			}
-			output["authentication_certificate"] = authenticationCertificates
+		output["protocol"] = string(props.Protocol)
 
-			trustedRootCertificateNames := make([]interface{}, 0)
-			if certs := props.TrustedRootCertificates; certs != nil {
-				for _, cert := range *certs {
-					if cert.ID == nil {
-						continue
-					}
+		if timeout := props.RequestTimeout; timeout != nil {
+			output["request_timeout"] = int(*timeout)
+		}
 
-					certId, err := parse.TrustedRootCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		authenticationCertificates := make([]interface{}, 0)
+		if certs := props.AuthenticationCertificates; certs != nil {
+			for _, cert := range *certs {
+				if cert.ID == nil {
+					continue
+				}
+
+				certId, err := parse.AuthenticationCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
+				}
 
-					trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Name)
+				certificate := map[string]interface{}{
+					"id":   certId.ID(),
+					"name": certId.Name,
 				}
+				authenticationCertificates = append(authenticationCertificates, certificate)
 			}
-			output["trusted_root_certificate_names"] = trustedRootCertificateNames
+		}
+		output["authentication_certificate"] = authenticationCertificates
 
-			if probe := props.Probe; probe != nil {
-				if probe.ID != nil {
-					id, err := parse.ProbeID(*probe.ID)
-					if err != nil {
-						return results, err
-					}
+		trustedRootCertificateNames := make([]interface{}, 0)
+		if certs := props.TrustedRootCertificates; certs != nil {
+			for _, cert := range *certs {
+				if cert.ID == nil {
+					continue
+				}
 
-					output["probe_name"] = id.Name
-					output["probe_id"] = id.ID()
+				certId, err := parse.TrustedRootCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
 				}
+
+				trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Name)
 			}
 		}
+		output["trusted_root_certificate_names"] = trustedRootCertificateNames
 
-		results = append(results, output)
+		if probe := props.Probe; probe != nil {
+			if probe.ID != nil {
+				id, err := parse.ProbeID(*probe.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				output["probe_name"] = id.Name
+				output["probe_id"] = id.ID()
+			}
+		}
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayConnectionDraining(d map[string]interface{}) *network.ApplicationGatewayConnectionDraining {
@@ -2775,75 +2813,82 @@ func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
-		frontendPortName := v["frontend_port_name"].(string)
-		protocol := v["protocol"].(string)
-		requireSNI := v["require_sni"].(bool)
-		sslProfileName := v["ssl_profile_name"].(string)
+		listener, err := expandApplicationGatewayHTTPListener(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
 
-		frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
-		frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
-		firewallPolicyID := v["firewall_policy_id"].(string)
+		results = append(results, *listener)
+	}
 
-		customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+	return &results, nil
+}
 
-		listener := network.ApplicationGatewayHTTPListener{
-			Name: utils.String(name),
-			ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
-				FrontendIPConfiguration: &network.SubResource{
-					ID: utils.String(frontendIPConfigID),
-				},
-				FrontendPort: &network.SubResource{
-					ID: utils.String(frontendPortID),
-				},
-				Protocol:                    network.ApplicationGatewayProtocol(protocol),
-				RequireServerNameIndication: utils.Bool(requireSNI),
-				CustomErrorConfigurations:   customErrorConfigurations,
+func expandApplicationGatewayHTTPListener(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayHTTPListener, error) {
+	name := v["name"].(string)
+	frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
+	frontendPortName := v["frontend_port_name"].(string)
+	protocol := v["protocol"].(string)
+	requireSNI := v["require_sni"].(bool)
+	sslProfileName := v["ssl_profile_name"].(string)
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
+	firewallPolicyID := v["firewall_policy_id"].(string)
+
+	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(name),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
 			},
-		}
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(protocol),
+			RequireServerNameIndication: utils.Bool(requireSNI),
+			CustomErrorConfigurations:   customErrorConfigurations,
+		},
+	}
 
-		host := v["host_name"].(string)
-		hosts := v["host_names"].(*pluginsdk.Set).List()
+	host := v["host_name"].(string)
+	hosts := v["host_names"].(*pluginsdk.Set).List()
 
-		if host != "" && len(hosts) > 0 {
-			return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
-		}
+	if host != "" && len(hosts) > 0 {
+		return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+	}
 
-		if host != "" {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
-		}
+	if host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
+	}
 
-		if len(hosts) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
-		}
+	if len(hosts) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
+	}
 
-		if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
-			certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
-				ID: utils.String(certID),
-			}
+	if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
 		}
+	}
 
-		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
-				ID: utils.String(firewallPolicyID),
-			}
+	if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyID),
 		}
+	}
 
-		if sslProfileName != "" && len(sslProfileName) > 0 {
-			sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
-				ID: utils.String(sslProfileID),
-			}
+	if sslProfileName != "" && len(sslProfileName) > 0 {
+		sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
+			ID: utils.String(sslProfileID),
 		}
-
-		results = append(results, listener)
 	}
 
-	return &results, nil
+	return &listener, nil
 }
 
 func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayHTTPListener) ([]interface{}, error) {
@@ -2853,88 +2898,97 @@ func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayH
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
-
-		if v.ID != nil {
-			output["id"] = *v.ID
+		output, err := flattenApplicationGatewayHTTPListener(v)
+		if err != nil {
+			return nil, err
 		}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+		results = append(results, output)
+	}
 
-		if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
-			if port := props.FrontendPort; port != nil {
-				if port.ID != nil {
-					portId, err := parse.FrontendPortID(*port.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_port_name"] = portId.Name
-					output["frontend_port_id"] = portId.ID()
-				}
-			}
+	return results, nil
+}
 
-			if feConfig := props.FrontendIPConfiguration; feConfig != nil {
-				if feConfig.ID != nil {
-					feConfigId, err := parse.FrontendIPConfigurationID(*feConfig.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_ip_configuration_name"] = feConfigId.Name
-					output["frontend_ip_configuration_id"] = feConfigId.ID()
+func flattenApplicationGatewayHTTPListener(v network.ApplicationGatewayHTTPListener) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
+
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
+
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
+
+	if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		if port := props.FrontendPort; port != nil {
+			if port.ID != nil {
+				portId, err := parse.FrontendPortID(*port.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["frontend_port_name"] = portId.Name
+				output["frontend_port_id"] = portId.ID()
 			}
+		}
 
-			if hostname := props.HostName; hostname != nil {
-				output["host_name"] = *hostname
+		if feConfig := props.FrontendIPConfiguration; feConfig != nil {
+			if feConfig.ID != nil {
+				feConfigId, err := parse.FrontendIPConfigurationID(*feConfig.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["frontend_ip_configuration_name"] = feConfigId.Name
+				output["frontend_ip_configuration_id"] = feConfigId.ID()
 			}
+		}
 
-			if hostnames := props.HostNames; hostnames != nil {
-				output["host_names"] = utils.FlattenStringSlice(hostnames)
-			}
+		if hostname := props.HostName; hostname != nil {
+			output["host_name"] = *hostname
+		}
 
-			output["protocol"] = string(props.Protocol)
+		if hostnames := props.HostNames; hostnames != nil {
+			output["host_names"] = utils.FlattenStringSlice(hostnames)
+		}
 
-			if cert := props.SslCertificate; cert != nil {
-				if cert.ID != nil {
-					certId, err := parse.SslCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		output["protocol"] = string(props.Protocol)
 
-					output["ssl_certificate_name"] = certId.Name
-					output["ssl_certificate_id"] = certId.ID()
+		if cert := props.SslCertificate; cert != nil {
+			if cert.ID != nil {
+				certId, err := parse.SslCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			if sni := props.RequireServerNameIndication; sni != nil {
-				output["require_sni"] = *sni
+				output["ssl_certificate_name"] = certId.Name
+				output["ssl_certificate_id"] = certId.ID()
 			}
+		}
 
-			if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
-				output["firewall_policy_id"] = *fwp.ID
-			}
+		if sni := props.RequireServerNameIndication; sni != nil {
+			output["require_sni"] = *sni
+		}
 
-			if sslp := props.SslProfile; sslp != nil {
-				if sslp.ID != nil {
-					sslProfileId, err := parse.SslProfileID(*sslp.ID)
-					if err != nil {
-						return nil, err
-					}
+		if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
+			output["firewall_policy_id"] = *fwp.ID
+		}
 
-					output["ssl_profile_name"] = sslProfileId.Name
-					output["ssl_profile_id"] = sslProfileId.ID()
+		if sslp := props.SslProfile; sslp != nil {
+			if sslp.ID != nil {
+				sslProfileId, err := parse.SslProfileID(*sslp.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
+				output["ssl_profile_name"] = sslProfileId.Name
+				output["ssl_profile_id"] = sslProfileId.ID()
+			}
 		}
 
-		results = append(results, output)
+		output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayIPConfigurations(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayIPConfiguration, bool) {
@@ -3202,59 +3256,61 @@ func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.Applic
 	results := make([]network.ApplicationGatewayProbe, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		results = append(results, expandApplicationGatewayProbe(raw.(map[string]interface{})))
+	}
 
-		host := v["host"].(string)
-		interval := int32(v["interval"].(int))
-		minServers := int32(v["minimum_servers"].(int))
-		name := v["name"].(string)
-		probePath := v["path"].(string)
-		protocol := v["protocol"].(string)
-		port := int32(v["port"].(int))
-		timeout := int32(v["timeout"].(int))
-		unhealthyThreshold := int32(v["unhealthy_threshold"].(int))
-		pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+	return &results
+}
 
-		output := network.ApplicationGatewayProbe{
-			Name: utils.String(name),
-			ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
-				Host:                                utils.String(host),
-				Interval:                            utils.Int32(interval),
-				MinServers:                          utils.Int32(minServers),
-				Path:                                utils.String(probePath),
-				Protocol:                            network.ApplicationGatewayProtocol(protocol),
-				Timeout:                             utils.Int32(timeout),
-				UnhealthyThreshold:                  utils.Int32(unhealthyThreshold),
-				PickHostNameFromBackendHTTPSettings: utils.Bool(pickHostNameFromBackendHTTPSettings),
-			},
-		}
+func expandApplicationGatewayProbe(v map[string]interface{}) network.ApplicationGatewayProbe {
+	host := v["host"].(string)
+	interval := int32(v["interval"].(int))
+	minServers := int32(v["minimum_servers"].(int))
+	name := v["name"].(string)
+	probePath := v["path"].(string)
+	protocol := v["protocol"].(string)
+	port := int32(v["port"].(int))
+	timeout := int32(v["timeout"].(int))
+	unhealthyThreshold := int32(v["unhealthy_threshold"].(int))
+	pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+
+	output := network.ApplicationGatewayProbe{
+		Name: utils.String(name),
+		ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
+			Host:                                utils.String(host),
+			Interval:                            utils.Int32(interval),
+			MinServers:                          utils.Int32(minServers),
+			Path:                                utils.String(probePath),
+			Protocol:                            network.ApplicationGatewayProtocol(protocol),
+			Timeout:                             utils.Int32(timeout),
+			UnhealthyThreshold:                  utils.Int32(unhealthyThreshold),
+			PickHostNameFromBackendHTTPSettings: utils.Bool(pickHostNameFromBackendHTTPSettings),
+		},
+	}
 
-		matchConfigs := v["match"].([]interface{})
-		if len(matchConfigs) > 0 {
-			matchBody := ""
-			outputMatch := &network.ApplicationGatewayProbeHealthResponseMatch{}
-			if matchConfigs[0] != nil {
-				match := matchConfigs[0].(map[string]interface{})
-				matchBody = match["body"].(string)
+	matchConfigs := v["match"].([]interface{})
+	if len(matchConfigs) > 0 {
+		matchBody := ""
+		outputMatch := &network.ApplicationGatewayProbeHealthResponseMatch{}
+		if matchConfigs[0] != nil {
+			match := matchConfigs[0].(map[string]interface{})
+			matchBody = match["body"].(string)
 
-				statusCodes := make([]string, 0)
-				for _, statusCode := range match["status_code"].([]interface{}) {
-					statusCodes = append(statusCodes, statusCode.(string))
-				}
-				outputMatch.StatusCodes = &statusCodes
+			statusCodes := make([]string, 0)
+			for _, statusCode := range match["status_code"].([]interface{}) {
+				statusCodes = append(statusCodes, statusCode.(string))
 			}
-			outputMatch.Body = utils.String(matchBody)
-			output.ApplicationGatewayProbePropertiesFormat.Match = outputMatch
-		}
-
-		if port != 0 {
-			output.ApplicationGatewayProbePropertiesFormat.Port = utils.Int32(port)
+			outputMatch.StatusCodes = &statusCodes
 		}
+		outputMatch.Body = utils.String(matchBody)
+		output.ApplicationGatewayProbePropertiesFormat.Match = outputMatch
+	}
 
-		results = append(results, output)
+	if port != 0 {
+		output.ApplicationGatewayProbePropertiesFormat.Port = utils.Int32(port)
 	}
 
-	return &results
+	return output
 }
 
 func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) []interface{} {
@@ -3264,76 +3320,80 @@ func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) [
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
+		results = append(results, flattenApplicationGatewayProbe(v))
+	}
 
-		if v.ID != nil {
-			output["id"] = *v.ID
-		}
+	return results
+}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+func flattenApplicationGatewayProbe(v network.ApplicationGatewayProbe) map[string]interface{} {
+	output := map[string]interface{}{}
 
-		if props := v.ApplicationGatewayProbePropertiesFormat; props != nil {
-			output["protocol"] = string(props.Protocol)
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
 
-			if host := props.Host; host != nil {
-				output["host"] = *host
-			}
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
 
-			if path := props.Path; path != nil {
-				output["path"] = *path
-			}
+	if props := v.ApplicationGatewayProbePropertiesFormat; props != nil {
+		output["protocol"] = string(props.Protocol)
 
-			if interval := props.Interval; interval != nil {
-				output["interval"] = int(*interval)
-			}
+		if host := props.Host; host != nil {
+			output["host"] = *host
+		}
 
-			if timeout := props.Timeout; timeout != nil {
-				output["timeout"] = int(*timeout)
-			}
+		if path := props.Path; path != nil {
+			output["path"] = *path
+		}
 
-			if threshold := props.UnhealthyThreshold; threshold != nil {
-				output["unhealthy_threshold"] = int(*threshold)
-			}
+		if interval := props.Interval; interval != nil {
+			output["interval"] = int(*interval)
+		}
 
-			port := 0
-			if props.Port != nil {
-				port = int(*props.Port)
-			}
-			output["port"] = port
+		if timeout := props.Timeout; timeout != nil {
+			output["timeout"] = int(*timeout)
+		}
 
-			if pickHostNameFromBackendHTTPSettings := props.PickHostNameFromBackendHTTPSettings; pickHostNameFromBackendHTTPSettings != nil {
-				output["pick_host_name_from_backend_http_settings"] = *pickHostNameFromBackendHTTPSettings
-			}
+		if threshold := props.UnhealthyThreshold; threshold != nil {
+			output["unhealthy_threshold"] = int(*threshold)
+		}
 
-			if minServers := props.MinServers; minServers != nil {
-				output["minimum_servers"] = int(*minServers)
-			}
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		output["port"] = port
 
-			matches := make([]interface{}, 0)
-			if match := props.Match; match != nil {
-				matchConfig := map[string]interface{}{}
-				if body := match.Body; body != nil {
-					matchConfig["body"] = *body
-				}
+		if pickHostNameFromBackendHTTPSettings := props.PickHostNameFromBackendHTTPSettings; pickHostNameFromBackendHTTPSettings != nil {
+			output["pick_host_name_from_backend_http_settings"] = *pickHostNameFromBackendHTTPSettings
+		}
 
-				statusCodes := make([]interface{}, 0)
-				if match.StatusCodes != nil {
-					for _, status := range *match.StatusCodes {
-						statusCodes = append(statusCodes, status)
-					}
+		if minServers := props.MinServers; minServers != nil {
+			output["minimum_servers"] = int(*minServers)
+		}
+
+		matches := make([]interface{}, 0)
+		if match := props.Match; match != nil {
+			matchConfig := map[string]interface{}{}
+			if body := match.Body; body != nil {
+				matchConfig["body"] = *body
+			}
+
+			statusCodes := make([]interface{}, 0)
+			if match.StatusCodes != nil {
+				for _, status := range *match.StatusCodes {
+					statusCodes = append(statusCodes, status)
 				}
-				matchConfig["status_code"] = statusCodes
-				matches = append(matches, matchConfig)
 			}
-			output["match"] = matches
+			matchConfig["status_code"] = statusCodes
+			matches = append(matches, matchConfig)
 		}
-
-		results = append(results, output)
+		output["match"] = matches
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayPrivateLinkConfigurations(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayPrivateLinkConfiguration {
@@ -3446,87 +3506,97 @@ func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gate
 	priorityset := false
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		ruleType := v["rule_type"].(string)
-		httpListenerName := v["http_listener_name"].(string)
-		httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
-		backendAddressPoolName := v["backend_address_pool_name"].(string)
-		backendHTTPSettingsName := v["backend_http_settings_name"].(string)
-		redirectConfigName := v["redirect_configuration_name"].(string)
-		priority := int32(v["priority"].(int))
-
-		rule := network.ApplicationGatewayRequestRoutingRule{
-			Name: utils.String(name),
-			ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
-				RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
-				HTTPListener: &network.SubResource{
-					ID: utils.String(httpListenerID),
-				},
-			},
+		rule, err := expandApplicationGatewayRequestRoutingRule(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
 		}
 
-		if backendAddressPoolName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+		if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority != nil {
+			priorityset = true
 		}
 
-		if backendHTTPSettingsName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+		results = append(results, *rule)
+	}
 
-		if backendAddressPoolName != "" {
-			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
-				ID: utils.String(backendAddressPoolID),
+	if priorityset {
+		for _, rule := range results {
+			if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority == nil {
+				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
 			}
 		}
+	}
 
-		if backendHTTPSettingsName != "" {
-			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
-				ID: utils.String(backendHTTPSettingsID),
-			}
-		}
+	return &results, nil
+}
 
-		if redirectConfigName != "" {
-			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
-				ID: utils.String(redirectConfigID),
-			}
-		}
+func expandApplicationGatewayRequestRoutingRule(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayRequestRoutingRule, error) {
+	name := v["name"].(string)
+	ruleType := v["rule_type"].(string)
+	httpListenerName := v["http_listener_name"].(string)
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
+	backendAddressPoolName := v["backend_address_pool_name"].(string)
+	backendHTTPSettingsName := v["backend_http_settings_name"].(string)
+	redirectConfigName := v["redirect_configuration_name"].(string)
+	priority := int32(v["priority"].(int))
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(name),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
+			},
+		},
+	}
 
-		if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
-			urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
-				ID: utils.String(urlPathMapID),
-			}
+	if backendAddressPoolName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
+
+	if backendHTTPSettingsName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
+
+	if backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
 		}
+	}
 
-		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
-				ID: utils.String(rewriteRuleSetID),
-			}
+	if backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
 		}
+	}
 
-		if priority != 0 {
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
-			priorityset = true
+	if redirectConfigName != "" {
+		redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(redirectConfigID),
 		}
+	}
 
-		results = append(results, rule)
+	if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
+		}
 	}
 
-	if priorityset {
-		for _, rule := range results {
-			if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority == nil {
-				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
-			}
+	if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+		rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(rewriteRuleSetID),
 		}
 	}
 
-	return &results, nil
+	if priority != 0 {
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
+	}
+
+	return &rule, nil
 }
 
 func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
@@ -3536,95 +3606,107 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
-			output := map[string]interface{}{
-				"rule_type": string(props.RuleType),
-			}
+		if config.ApplicationGatewayRequestRoutingRulePropertiesFormat == nil {
+			continue
+		}
 
-			if config.ID != nil {
-				output["id"] = *config.ID
-			}
+		output, err := flattenApplicationGatewayRequestRoutingRule(config)
+		if err != nil {
+			return nil, err
+		}
 
-			if config.Name != nil {
-				output["name"] = *config.Name
-			}
+		results = append(results, output)
+	}
 
-			if config.Priority != nil {
-				output["priority"] = *config.Priority
-			}
+	return results, nil
+}
 
-			if pool := props.BackendAddressPool; pool != nil {
-				if pool.ID != nil {
-					poolId, err := parse.BackendAddressPoolID(*pool.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["backend_address_pool_name"] = poolId.Name
-					output["backend_address_pool_id"] = poolId.ID()
-				}
-			}
+func flattenApplicationGatewayRequestRoutingRule(config network.ApplicationGatewayRequestRoutingRule) (map[string]interface{}, error) {
+	props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat
+	output := map[string]interface{}{
+		"rule_type": string(props.RuleType),
+	}
 
-			if settings := props.BackendHTTPSettings; settings != nil {
-				if settings.ID != nil {
-					settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
-					if err != nil {
-						return nil, err
-					}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-					output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
-					output["backend_http_settings_id"] = *settings.ID
-				}
+	if config.Name != nil {
+		output["name"] = *config.Name
+	}
+
+	if config.Priority != nil {
+		output["priority"] = *config.Priority
+	}
+
+	if pool := props.BackendAddressPool; pool != nil {
+		if pool.ID != nil {
+			poolId, err := parse.BackendAddressPoolID(*pool.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["backend_address_pool_name"] = poolId.Name
+			output["backend_address_pool_id"] = poolId.ID()
+		}
+	}
 
-			if listener := props.HTTPListener; listener != nil {
-				if listener.ID != nil {
-					listenerId, err := parse.HttpListenerID(*listener.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["http_listener_id"] = listenerId.ID()
-					output["http_listener_name"] = listenerId.Name
-				}
+	if settings := props.BackendHTTPSettings; settings != nil {
+		if settings.ID != nil {
+			settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
+			if err != nil {
+				return nil, err
 			}
 
-			if pathMap := props.URLPathMap; pathMap != nil {
-				if pathMap.ID != nil {
-					pathMapId, err := parse.UrlPathMapID(*pathMap.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["url_path_map_name"] = pathMapId.Name
-					output["url_path_map_id"] = pathMapId.ID()
-				}
+			output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
+			output["backend_http_settings_id"] = *settings.ID
+		}
+	}
+
+	if listener := props.HTTPListener; listener != nil {
+		if listener.ID != nil {
+			listenerId, err := parse.HttpListenerID(*listener.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["http_listener_id"] = listenerId.ID()
+			output["http_listener_name"] = listenerId.Name
+		}
+	}
 
-			if redirect := props.RedirectConfiguration; redirect != nil {
-				if redirect.ID != nil {
-					redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
-					output["redirect_configuration_id"] = redirectId.ID()
-				}
+	if pathMap := props.URLPathMap; pathMap != nil {
+		if pathMap.ID != nil {
+			pathMapId, err := parse.UrlPathMapID(*pathMap.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["url_path_map_name"] = pathMapId.Name
+			output["url_path_map_id"] = pathMapId.ID()
+		}
+	}
 
-			if rewrite := props.RewriteRuleSet; rewrite != nil {
-				if rewrite.ID != nil {
-					rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["rewrite_rule_set_name"] = rewriteId.Name
-					output["rewrite_rule_set_id"] = rewriteId.ID()
-				}
+	if redirect := props.RedirectConfiguration; redirect != nil {
+		if redirect.ID != nil {
+			redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
+			output["redirect_configuration_id"] = redirectId.ID()
+		}
+	}
 
-			results = append(results, output)
+	if rewrite := props.RewriteRuleSet; rewrite != nil {
+		if rewrite.ID != nil {
+			rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
+			if err != nil {
+				return nil, err
+			}
+			output["rewrite_rule_set_name"] = rewriteId.Name
+			output["rewrite_rule_set_id"] = rewriteId.ID()
 		}
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayRewriteRuleSet, error) {
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayRoutingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRoutingRuleCreate,
+		Read:   resourceApplicationGatewayRoutingRuleRead,
+		Update: resourceApplicationGatewayRoutingRuleUpdate,
+		Delete: resourceApplicationGatewayRoutingRuleDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RequestRoutingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildSchema("request_routing_rule"),
+	}
+}
+
+func resourceApplicationGatewayRoutingRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewRequestRoutingRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	rule, err := expandApplicationGatewayRequestRoutingRule(applicationGatewayChildConfig(d, "request_routing_rule"), gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	err = updateApplicationGatewayChild(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			rules = *props.RequestRoutingRules
+		}
+
+		for _, v := range rules {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				return tf.ImportAsExistsError("azurerm_application_gateway_routing_rule", id.ID())
+			}
+		}
+
+		rules = append(rules, *rule)
+		props.RequestRoutingRules = &rules
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRoutingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	applicationGateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var rule *network.ApplicationGatewayRequestRoutingRule
+	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if utils.NormalizeNilableString(v.Name) == id.Name && v.ApplicationGatewayRequestRoutingRulePropertiesFormat != nil {
+				v := v
+				rule = &v
+				break
+			}
+		}
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	flattened, err := flattenApplicationGatewayRequestRoutingRule(*rule)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return applicationGatewayChildSetAttributes(d, "request_routing_rule", flattened)
+}
+
+func resourceApplicationGatewayRoutingRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	rule, err := expandApplicationGatewayRequestRoutingRule(applicationGatewayChildConfig(d, "request_routing_rule"), gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", *id, err)
+	}
+
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.RequestRoutingRules != nil {
+			for i, v := range *props.RequestRoutingRules {
+				if utils.NormalizeNilableString(v.Name) == id.Name {
+					(*props.RequestRoutingRules)[i] = *rule
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRoutingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewayChild(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			for _, v := range *props.RequestRoutingRules {
+				if utils.NormalizeNilableString(v.Name) != id.Name {
+					rules = append(rules, v)
+				}
+			}
+		}
+		props.RequestRoutingRules = &rules
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayRoutingRuleResource struct{}
+
+func TestAccApplicationGatewayRoutingRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRoutingRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_application_gateway_routing_rule"),
+		},
+	})
+}
+
+func TestAccApplicationGatewayRoutingRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backend_address_pool_name").HasValue(fmt.Sprintf("acctest-beap-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayRoutingRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RequestRoutingRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("reading Application Gateway Routing Rule (%s): %+v", id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if utils.NormalizeNilableString(v.Name) == id.Name {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewayRoutingRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_application_gateway_listener" "test" {
+  name                           = "acctest-httplstn-%[2]d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.child_frontend_port_name
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_routing_rule" "test" {
+  name                       = "acctest-rqrt-%[2]d"
+  application_gateway_id     = azurerm_application_gateway.test.id
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_listener.test.name
+  backend_address_pool_name  = local.backend_address_pool_name
+  backend_http_settings_name = local.http_setting_name
+}
+`, ApplicationGatewayResource{}.ignoreExternallyManagedChildren(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayRoutingRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_routing_rule" "import" {
+  name                       = azurerm_application_gateway_routing_rule.test.name
+  application_gateway_id     = azurerm_application_gateway_routing_rule.test.application_gateway_id
+  rule_type                  = azurerm_application_gateway_routing_rule.test.rule_type
+  http_listener_name         = azurerm_application_gateway_routing_rule.test.http_listener_name
+  backend_address_pool_name  = azurerm_application_gateway_routing_rule.test.backend_address_pool_name
+  backend_http_settings_name = azurerm_application_gateway_routing_rule.test.backend_http_settings_name
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayRoutingRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_application_gateway_listener" "test" {
+  name                           = "acctest-httplstn-%[2]d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.child_frontend_port_name
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_backend_pool" "test" {
+  name                   = "acctest-beap-%[2]d"
+  application_gateway_id = azurerm_application_gateway.test.id
+}
+
+resource "azurerm_application_gateway_routing_rule" "test" {
+  name                       = "acctest-rqrt-%[2]d"
+  application_gateway_id     = azurerm_application_gateway.test.id
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_listener.test.name
+  backend_address_pool_name  = azurerm_application_gateway_backend_pool.test.name
+  backend_http_settings_name = local.http_setting_name
+}
+`, ApplicationGatewayResource{}.ignoreExternallyManagedChildren(data), data.RandomInteger)
+}
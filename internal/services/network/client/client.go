@@ -3,65 +3,83 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagercommits"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagerdeploymentstatus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/staticmembers"
 )
 
 type Client struct {
-	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
-	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
-	BastionHostsClient                     *network.BastionHostsClient
-	ConfigurationPolicyGroupClient         *network.ConfigurationPolicyGroupsClient
-	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
-	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
-	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
-	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
-	ExpressRouteCircuitConnectionClient    *network.ExpressRouteCircuitConnectionsClient
-	ExpressRouteConnectionsClient          *network.ExpressRouteConnectionsClient
-	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
-	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
-	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
-	FlowLogsClient                         *network.FlowLogsClient
-	HubRouteTableClient                    *network.HubRouteTablesClient
-	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
-	InterfacesClient                       *network.InterfacesClient
-	IPGroupsClient                         *network.IPGroupsClient
-	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
-	NatRuleClient                          *network.NatRulesClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
-	ProfileClient                          *network.ProfilesClient
-	PacketCapturesClient                   *network.PacketCapturesClient
-	PrivateEndpointClient                  *network.PrivateEndpointsClient
-	PublicIPsClient                        *network.PublicIPAddressesClient
-	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
-	RoutesClient                           *network.RoutesClient
-	RouteFiltersClient                     *network.RouteFiltersClient
-	RouteTablesClient                      *network.RouteTablesClient
-	SecurityGroupClient                    *network.SecurityGroupsClient
-	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
-	SecurityRuleClient                     *network.SecurityRulesClient
-	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
-	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
-	ServiceTagsClient                      *network.ServiceTagsClient
-	SubnetsClient                          *network.SubnetsClient
-	NatGatewayClient                       *network.NatGatewaysClient
-	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
-	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
-	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
-	VnetGatewayNatRuleClient               *network.VirtualNetworkGatewayNatRulesClient
-	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
-	VnetClient                             *network.VirtualNetworksClient
-	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
-	VirtualWanClient                       *network.VirtualWansClient
-	VirtualHubClient                       *network.VirtualHubsClient
-	VpnConnectionsClient                   *network.VpnConnectionsClient
-	VpnGatewaysClient                      *network.VpnGatewaysClient
-	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
-	VpnSitesClient                         *network.VpnSitesClient
-	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
-	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
-	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
-	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
-	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+	ApplicationGatewaysClient                *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient          *network.ApplicationSecurityGroupsClient
+	BastionHostsClient                       *network.BastionHostsClient
+	ConfigurationPolicyGroupClient           *network.ConfigurationPolicyGroupsClient
+	ConnectionMonitorsClient                 *network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient                *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                  *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient               *network.ExpressRouteCircuitsClient
+	ExpressRouteCircuitConnectionClient      *network.ExpressRouteCircuitConnectionsClient
+	ExpressRouteConnectionsClient            *network.ExpressRouteConnectionsClient
+	ExpressRouteGatewaysClient               *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient               *network.ExpressRouteCircuitPeeringsClient
+	ExpressRoutePortsClient                  *network.ExpressRoutePortsClient
+	FlowLogsClient                           *network.FlowLogsClient
+	HubRouteTableClient                      *network.HubRouteTablesClient
+	HubVirtualNetworkConnectionClient        *network.HubVirtualNetworkConnectionsClient
+	InterfacesClient                         *network.InterfacesClient
+	IPGroupsClient                           *network.IPGroupsClient
+	ManagerAdminRuleCollectionsClient        *adminrulecollections.AdminRuleCollectionsClient
+	ManagerAdminRulesClient                  *adminrules.AdminRulesClient
+	ManagerCommitsClient                     *networkmanagercommits.NetworkManagerCommitsClient
+	ManagerConnectivityConfigurationsClient  *connectivityconfigurations.ConnectivityConfigurationsClient
+	ManagerDeploymentStatusClient            *networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient
+	ManagerNetworkGroupsClient               *networkgroups.NetworkGroupsClient
+	ManagerSecurityAdminConfigurationsClient *securityadminconfigurations.SecurityAdminConfigurationsClient
+	ManagerStaticMembersClient               *staticmembers.StaticMembersClient
+	ManagersClient                           *networkmanagers.NetworkManagersClient
+	LocalNetworkGatewaysClient               *network.LocalNetworkGatewaysClient
+	NatRuleClient                            *network.NatRulesClient
+	PointToSiteVpnGatewaysClient             *network.P2sVpnGatewaysClient
+	ProfileClient                            *network.ProfilesClient
+	PacketCapturesClient                     *network.PacketCapturesClient
+	PrivateEndpointClient                    *network.PrivateEndpointsClient
+	PublicIPsClient                          *network.PublicIPAddressesClient
+	PublicIPPrefixesClient                   *network.PublicIPPrefixesClient
+	RoutesClient                             *network.RoutesClient
+	RouteFiltersClient                       *network.RouteFiltersClient
+	RouteTablesClient                        *network.RouteTablesClient
+	SecurityGroupClient                      *network.SecurityGroupsClient
+	SecurityPartnerProviderClient            *network.SecurityPartnerProvidersClient
+	SecurityRuleClient                       *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient            *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient   *network.ServiceEndpointPolicyDefinitionsClient
+	ServiceTagsClient                        *network.ServiceTagsClient
+	SubnetsClient                            *network.SubnetsClient
+	NatGatewayClient                         *network.NatGatewaysClient
+	VirtualHubBgpConnectionClient            *network.VirtualHubBgpConnectionClient
+	VirtualHubIPClient                       *network.VirtualHubIPConfigurationClient
+	VnetGatewayConnectionsClient             *network.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayNatRuleClient                 *network.VirtualNetworkGatewayNatRulesClient
+	VnetGatewayClient                        *network.VirtualNetworkGatewaysClient
+	VnetClient                               *network.VirtualNetworksClient
+	VnetPeeringsClient                       *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                         *network.VirtualWansClient
+	VirtualHubClient                         *network.VirtualHubsClient
+	VpnConnectionsClient                     *network.VpnConnectionsClient
+	VpnGatewaysClient                        *network.VpnGatewaysClient
+	VpnServerConfigurationsClient            *network.VpnServerConfigurationsClient
+	VpnSitesClient                           *network.VpnSitesClient
+	WatcherClient                            *network.WatchersClient
+	WebApplicationFirewallPoliciesClient     *network.WebApplicationFirewallPoliciesClient
+	PrivateDnsZoneGroupClient                *network.PrivateDNSZoneGroupsClient
+	PrivateLinkServiceClient                 *network.PrivateLinkServicesClient
+	ServiceAssociationLinkClient             *network.ServiceAssociationLinksClient
+	ResourceNavigationLinkClient             *network.ResourceNavigationLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -233,62 +251,98 @@ func NewClient(o *common.ClientOptions) *Client {
 	ResourceNavigationLinkClient := network.NewResourceNavigationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
+	managerAdminRuleCollectionsClient := adminrulecollections.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerAdminRulesClient := adminrules.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	managerCommitsClient := networkmanagercommits.NewNetworkManagerCommitsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerCommitsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerConnectivityConfigurationsClient := connectivityconfigurations.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerDeploymentStatusClient := networkmanagerdeploymentstatus.NewNetworkManagerDeploymentStatusClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerDeploymentStatusClient.Client, o.ResourceManagerAuthorizer)
+
+	managerNetworkGroupsClient := networkgroups.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerSecurityAdminConfigurationsClient := securityadminconfigurations.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerSecurityAdminConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerStaticMembersClient := staticmembers.NewStaticMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerStaticMembersClient.Client, o.ResourceManagerAuthorizer)
+
+	managersClient := networkmanagers.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managersClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
-		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
-		BastionHostsClient:                     &BastionHostsClient,
-		ConfigurationPolicyGroupClient:         &configurationPolicyGroupClient,
-		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
-		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
-		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
-		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
-		ExpressRouteCircuitConnectionClient:    &ExpressRouteCircuitConnectionClient,
-		ExpressRouteConnectionsClient:          &ExpressRouteConnectionsClient,
-		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
-		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
-		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
-		FlowLogsClient:                         &FlowLogsClient,
-		HubRouteTableClient:                    &HubRouteTableClient,
-		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
-		InterfacesClient:                       &InterfacesClient,
-		IPGroupsClient:                         &IpGroupsClient,
-		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
-		NatRuleClient:                          &NatRuleClient,
-		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
-		ProfileClient:                          &ProfileClient,
-		PacketCapturesClient:                   &PacketCapturesClient,
-		PrivateEndpointClient:                  &PrivateEndpointClient,
-		PublicIPsClient:                        &PublicIPsClient,
-		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
-		RoutesClient:                           &RoutesClient,
-		RouteFiltersClient:                     &RouteFiltersClient,
-		RouteTablesClient:                      &RouteTablesClient,
-		SecurityGroupClient:                    &SecurityGroupClient,
-		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
-		SecurityRuleClient:                     &SecurityRuleClient,
-		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
-		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
-		ServiceTagsClient:                      &ServiceTagsClient,
-		SubnetsClient:                          &SubnetsClient,
-		NatGatewayClient:                       &NatGatewayClient,
-		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
-		VirtualHubIPClient:                     &VirtualHubIPClient,
-		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
-		VnetGatewayNatRuleClient:               &VnetGatewayNatRuleClient,
-		VnetGatewayClient:                      &VnetGatewayClient,
-		VnetClient:                             &VnetClient,
-		VnetPeeringsClient:                     &VnetPeeringsClient,
-		VirtualWanClient:                       &VirtualWanClient,
-		VirtualHubClient:                       &VirtualHubClient,
-		VpnConnectionsClient:                   &vpnConnectionsClient,
-		VpnGatewaysClient:                      &vpnGatewaysClient,
-		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
-		VpnSitesClient:                         &vpnSitesClient,
-		WatcherClient:                          &WatcherClient,
-		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
-		PrivateDnsZoneGroupClient:              &PrivateDnsZoneGroupClient,
-		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
-		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
-		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+		ApplicationGatewaysClient:                &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:          &ApplicationSecurityGroupsClient,
+		BastionHostsClient:                       &BastionHostsClient,
+		ConfigurationPolicyGroupClient:           &configurationPolicyGroupClient,
+		ConnectionMonitorsClient:                 &ConnectionMonitorsClient,
+		DDOSProtectionPlansClient:                &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                  &ExpressRouteAuthsClient,
+		ExpressRouteCircuitsClient:               &ExpressRouteCircuitsClient,
+		ExpressRouteCircuitConnectionClient:      &ExpressRouteCircuitConnectionClient,
+		ExpressRouteConnectionsClient:            &ExpressRouteConnectionsClient,
+		ExpressRouteGatewaysClient:               &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:               &ExpressRoutePeeringsClient,
+		ExpressRoutePortsClient:                  &ExpressRoutePortsClient,
+		FlowLogsClient:                           &FlowLogsClient,
+		HubRouteTableClient:                      &HubRouteTableClient,
+		HubVirtualNetworkConnectionClient:        &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                         &InterfacesClient,
+		IPGroupsClient:                           &IpGroupsClient,
+		ManagerAdminRuleCollectionsClient:        &managerAdminRuleCollectionsClient,
+		ManagerAdminRulesClient:                  &managerAdminRulesClient,
+		ManagerCommitsClient:                     &managerCommitsClient,
+		ManagerConnectivityConfigurationsClient:  &managerConnectivityConfigurationsClient,
+		ManagerDeploymentStatusClient:            &managerDeploymentStatusClient,
+		ManagerNetworkGroupsClient:               &managerNetworkGroupsClient,
+		ManagerSecurityAdminConfigurationsClient: &managerSecurityAdminConfigurationsClient,
+		ManagerStaticMembersClient:               &managerStaticMembersClient,
+		ManagersClient:                           &managersClient,
+		LocalNetworkGatewaysClient:               &LocalNetworkGatewaysClient,
+		NatRuleClient:                            &NatRuleClient,
+		PointToSiteVpnGatewaysClient:             &pointToSiteVpnGatewaysClient,
+		ProfileClient:                            &ProfileClient,
+		PacketCapturesClient:                     &PacketCapturesClient,
+		PrivateEndpointClient:                    &PrivateEndpointClient,
+		PublicIPsClient:                          &PublicIPsClient,
+		PublicIPPrefixesClient:                   &PublicIPPrefixesClient,
+		RoutesClient:                             &RoutesClient,
+		RouteFiltersClient:                       &RouteFiltersClient,
+		RouteTablesClient:                        &RouteTablesClient,
+		SecurityGroupClient:                      &SecurityGroupClient,
+		SecurityPartnerProviderClient:            &SecurityPartnerProviderClient,
+		SecurityRuleClient:                       &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:            &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient:   &ServiceEndpointPolicyDefinitionsClient,
+		ServiceTagsClient:                        &ServiceTagsClient,
+		SubnetsClient:                            &SubnetsClient,
+		NatGatewayClient:                         &NatGatewayClient,
+		VirtualHubBgpConnectionClient:            &VirtualHubBgpConnectionClient,
+		VirtualHubIPClient:                       &VirtualHubIPClient,
+		VnetGatewayConnectionsClient:             &VnetGatewayConnectionsClient,
+		VnetGatewayNatRuleClient:                 &VnetGatewayNatRuleClient,
+		VnetGatewayClient:                        &VnetGatewayClient,
+		VnetClient:                               &VnetClient,
+		VnetPeeringsClient:                       &VnetPeeringsClient,
+		VirtualWanClient:                         &VirtualWanClient,
+		VirtualHubClient:                         &VirtualHubClient,
+		VpnConnectionsClient:                     &vpnConnectionsClient,
+		VpnGatewaysClient:                        &vpnGatewaysClient,
+		VpnServerConfigurationsClient:            &vpnServerConfigurationsClient,
+		VpnSitesClient:                           &vpnSitesClient,
+		WatcherClient:                            &WatcherClient,
+		WebApplicationFirewallPoliciesClient:     &WebApplicationFirewallPoliciesClient,
+		PrivateDnsZoneGroupClient:                &PrivateDnsZoneGroupClient,
+		PrivateLinkServiceClient:                 &PrivateLinkServiceClient,
+		ServiceAssociationLinkClient:             &ServiceAssociationLinkClient,
+		ResourceNavigationLinkClient:             &ResourceNavigationLinkClient,
 	}
 }
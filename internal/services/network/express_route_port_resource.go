@@ -3,6 +3,7 @@ package network
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -38,30 +40,28 @@ var expressRoutePortSchema = &pluginsdk.Schema{
 			"macsec_cipher": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-
-				// TODO: The following hardcode can be replaced by SDK types once following is merged:
-				// 	https://github.com/Azure/azure-rest-api-specs/pull/12329
-				Default: "GcmAes128",
-				// Default: string(network.GcmAes128),
-
-				// TODO: The following hardcode can be replaced by SDK types once following is merged:
-				// 	https://github.com/Azure/azure-rest-api-specs/pull/12329
+				Default:  string(network.ExpressRouteLinkMacSecCipherGcmAes128),
 				ValidateFunc: validation.StringInSlice([]string{
-					"GcmAes128",
-					"GcmAes256",
-					// string(network.GcmAes128),
-					// string(network.GcmAes256),
+					string(network.ExpressRouteLinkMacSecCipherGcmAes128),
+					string(network.ExpressRouteLinkMacSecCipherGcmAes256),
+					string(network.ExpressRouteLinkMacSecCipherGcmAesXpn128),
+					string(network.ExpressRouteLinkMacSecCipherGcmAesXpn256),
 				}, false),
 			},
 			"macsec_ckn_keyvault_secret_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 			},
 			"macsec_cak_keyvault_secret_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+			"macsec_sci_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"id": {
 				Type:     pluginsdk.TypeString,
@@ -93,9 +93,9 @@ var expressRoutePortSchema = &pluginsdk.Schema{
 
 func resourceArmExpressRoutePort() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceArmExpressRoutePortCreateUpdate,
+		Create: resourceArmExpressRoutePortCreate,
 		Read:   resourceArmExpressRoutePortRead,
-		Update: resourceArmExpressRoutePortCreateUpdate,
+		Update: resourceArmExpressRoutePortUpdate,
 		Delete: resourceArmExpressRoutePortDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -172,10 +172,10 @@ func resourceArmExpressRoutePort() *pluginsdk.Resource {
 	}
 }
 
-func resourceArmExpressRoutePortCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceArmExpressRoutePortCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
@@ -184,19 +184,17 @@ func resourceArmExpressRoutePortCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	id := parse.NewExpressRoutePortID(subscriptionId, resourceGroup, name)
 
-	if d.IsNewResource() {
-		resp, err := client.Get(ctx, resourceGroup, name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("checking for existing Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
-			}
-		}
-
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
-			return tf.ImportAsExistsError("azurerm_express_route_port", id.ID())
+			return fmt.Errorf("checking for existing Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 	}
 
+	if !utils.ResponseWasNotFound(resp.Response) {
+		return tf.ImportAsExistsError("azurerm_express_route_port", id.ID())
+	}
+
 	expandedIdentity, err := expandExpressRoutePortIdentity(d.Get("identity").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
@@ -214,20 +212,18 @@ func resourceArmExpressRoutePortCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	// The link properties can't be specified in first creation. It will result into either error (e.g. setting `adminState`) or being ignored (e.g. setting MACSec)
-	// Hence, if this is a new creation we will do a create-then-update here.
-	if d.IsNewResource() {
-		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, param)
-		if err != nil {
-			return fmt.Errorf("creating Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
-		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for creation of Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
+	// Hence we will do a create-then-update here.
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, param)
+	if err != nil {
+		return fmt.Errorf("creating Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	param.ExpressRoutePortPropertiesFormat.Links = expandExpressRoutePortLinks(d.Get("link1").([]interface{}), d.Get("link2").([]interface{}))
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, param)
+	future, err = client.CreateOrUpdate(ctx, resourceGroup, name, param)
 	if err != nil {
 		return fmt.Errorf("creating Express Route Port %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -240,6 +236,63 @@ func resourceArmExpressRoutePortCreateUpdate(d *pluginsdk.ResourceData, meta int
 	return resourceArmExpressRoutePortRead(d, meta)
 }
 
+func resourceArmExpressRoutePortUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the existing Express Route Port is used as the basis of the update, so that only the links which have changed are
+	// modified - leaving the admin state and MACSec configuration of the other link (and any circuits using it) untouched
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Express Route Port %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if existing.ExpressRoutePortPropertiesFormat == nil {
+		return fmt.Errorf("retrieving Express Route Port %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	if d.HasChange("identity") {
+		expandedIdentity, err := expandExpressRoutePortIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		existing.Identity = expandedIdentity
+	}
+
+	for i, key := range []string{"link1", "link2"} {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		link := expandExpressRoutePortLink(i+1, d.Get(key).([]interface{}))
+		if link == nil {
+			continue
+		}
+		if err := updateExpressRoutePortLink(existing.ExpressRoutePortPropertiesFormat.Links, *link); err != nil {
+			return fmt.Errorf("updating `%s`: %+v", key, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+	if err != nil {
+		return fmt.Errorf("updating Express Route Port %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Express Route Port %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return resourceArmExpressRoutePortRead(d, meta)
+}
+
 func resourceArmExpressRoutePortRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ExpressRoutePortsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -391,7 +444,8 @@ func expandExpressRoutePortLink(idx int, input []interface{}) *network.ExpressRo
 		ExpressRouteLinkPropertiesFormat: &network.ExpressRouteLinkPropertiesFormat{
 			AdminState: adminState,
 			MacSecConfig: &network.ExpressRouteLinkMacSecConfig{
-				Cipher: network.ExpressRouteLinkMacSecCipher(b["macsec_cipher"].(string)),
+				Cipher:   network.ExpressRouteLinkMacSecCipher(b["macsec_cipher"].(string)),
+				SciState: network.ExpressRouteLinkMacSecSciStateDisabled,
 			},
 		},
 	}
@@ -402,9 +456,33 @@ func expandExpressRoutePortLink(idx int, input []interface{}) *network.ExpressRo
 	if cakSecretId := b["macsec_cak_keyvault_secret_id"].(string); cakSecretId != "" {
 		link.ExpressRouteLinkPropertiesFormat.MacSecConfig.CakSecretIdentifier = &cakSecretId
 	}
+	if b["macsec_sci_enabled"].(bool) {
+		link.ExpressRouteLinkPropertiesFormat.MacSecConfig.SciState = network.ExpressRouteLinkMacSecSciStateEnabled
+	}
 	return &link
 }
 
+// updateExpressRoutePortLink replaces the admin state and MACSec configuration of the existing link with the same name
+func updateExpressRoutePortLink(links *[]network.ExpressRouteLink, link network.ExpressRouteLink) error {
+	name := utils.NormalizeNilableString(link.Name)
+	if links != nil {
+		for i, v := range *links {
+			if !strings.EqualFold(utils.NormalizeNilableString(v.Name), name) {
+				continue
+			}
+
+			if v.ExpressRouteLinkPropertiesFormat == nil {
+				(*links)[i].ExpressRouteLinkPropertiesFormat = &network.ExpressRouteLinkPropertiesFormat{}
+			}
+			(*links)[i].ExpressRouteLinkPropertiesFormat.AdminState = link.ExpressRouteLinkPropertiesFormat.AdminState
+			(*links)[i].ExpressRouteLinkPropertiesFormat.MacSecConfig = link.ExpressRouteLinkPropertiesFormat.MacSecConfig
+			return nil
+		}
+	}
+
+	return fmt.Errorf("link %q was not found", name)
+}
+
 func flattenExpressRoutePortLinks(links *[]network.ExpressRouteLink) ([]interface{}, []interface{}, error) {
 	if links == nil {
 		return nil, nil, nil
@@ -414,7 +492,20 @@ func flattenExpressRoutePortLinks(links *[]network.ExpressRouteLink) ([]interfac
 		return nil, nil, fmt.Errorf("expected two links, but got %d", length)
 	}
 
-	return flattenExpressRoutePortLink((*links)[0]), flattenExpressRoutePortLink((*links)[1]), nil
+	// the links are matched by name, since the order returned by the API isn't guaranteed
+	var link1, link2 []interface{}
+	for _, link := range *links {
+		switch name := utils.NormalizeNilableString(link.Name); strings.ToLower(name) {
+		case "link1":
+			link1 = flattenExpressRoutePortLink(link)
+		case "link2":
+			link2 = flattenExpressRoutePortLink(link)
+		default:
+			return nil, nil, fmt.Errorf("unexpected link %q", name)
+		}
+	}
+
+	return link1, link2, nil
 }
 
 func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
@@ -433,6 +524,7 @@ func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
 		cknSecretId   string
 		cakSecretId   string
 		cipher        string
+		sciState      bool
 	)
 
 	if prop := link.ExpressRouteLinkPropertiesFormat; prop != nil {
@@ -458,6 +550,7 @@ func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
 				cakSecretId = *cfg.CakSecretIdentifier
 			}
 			cipher = string(cfg.Cipher)
+			sciState = cfg.SciState == network.ExpressRouteLinkMacSecSciStateEnabled
 		}
 	}
 
@@ -473,6 +566,7 @@ func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
 			"macsec_ckn_keyvault_secret_id": cknSecretId,
 			"macsec_cak_keyvault_secret_id": cakSecretId,
 			"macsec_cipher":                 cipher,
+			"macsec_sci_enabled":            sciState,
 		},
 	}
 }
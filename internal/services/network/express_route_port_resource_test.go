@@ -66,6 +66,35 @@ func TestAccAzureRMExpressRoutePort_adminState(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMExpressRoutePort_adminStateUpdate(t *testing.T) {
+	if _, ok := os.LookupEnv(ARMTestExpressRoutePortAdminState); !ok {
+		t.Skip(fmt.Sprintf("Enabling admin state will cause high cost, please set environment variable %q if you want to test it.", ARMTestExpressRoutePortAdminState))
+	}
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.adminState(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.admin_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("link2.0.admin_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.adminStateUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.admin_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("link2.0.admin_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMExpressRoutePort_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
 	r := ExpressRoutePortResource{}
@@ -111,6 +140,39 @@ func TestAccAzureRMExpressRoutePort_linkCipher(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMExpressRoutePort_linkCipherUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port", "test")
+	r := ExpressRoutePortResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkCipher(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.linkCipherUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link1.0.macsec_cipher").HasValue("GcmAesXpn256"),
+				check.That(data.ResourceName).Key("link1.0.macsec_sci_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("link2.0.macsec_cipher").HasValue("GcmAes128"),
+				check.That(data.ResourceName).Key("link2.0.macsec_sci_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.linkCipher(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ExpressRoutePortResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	client := clients.Network.ExpressRoutePortsClient
 
@@ -167,6 +229,28 @@ resource "azurerm_express_route_port" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ExpressRoutePortResource) adminStateUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Area51-ERDirect"
+  bandwidth_in_gbps   = 1
+  encapsulation       = "Dot1Q"
+  link1 {
+    admin_enabled = false
+  }
+  link2 {
+    admin_enabled = true
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r ExpressRoutePortResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -210,6 +294,67 @@ resource "azurerm_express_route_port" "test" {
 }
 
 func (r ExpressRoutePortResource) linkCipher(data acceptance.TestData) string {
+	template := r.linkCipherTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "CDC-Canberra2"
+  bandwidth_in_gbps   = 1
+  encapsulation       = "Dot1Q"
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+  link1 {
+    macsec_cipher                 = "GcmAes256"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+  link2 {
+    macsec_cipher                 = "GcmAes128"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+}
+`, template, data.RandomIntOfLength(8))
+}
+
+func (r ExpressRoutePortResource) linkCipherUpdated(data acceptance.TestData) string {
+	template := r.linkCipherTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctestERP-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "CDC-Canberra2"
+  bandwidth_in_gbps   = 1
+  encapsulation       = "Dot1Q"
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+  link1 {
+    macsec_cipher                 = "GcmAesXpn256"
+    macsec_sci_enabled            = true
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+  link2 {
+    macsec_cipher                 = "GcmAes128"
+    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
+    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
+  }
+}
+`, template, data.RandomIntOfLength(8))
+}
+
+func (r ExpressRoutePortResource) linkCipherTemplate(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
 %s
@@ -260,29 +405,6 @@ resource "azurerm_key_vault_secret" "ckn" {
   value        = "dffafc8d7b9a43d5b9a3dfbbf6a30c16"
   key_vault_id = azurerm_key_vault.test.id
 }
-
-resource "azurerm_express_route_port" "test" {
-  name                = "acctestERP-%[2]d"
-  resource_group_name = azurerm_resource_group.test.name
-  location            = azurerm_resource_group.test.location
-  peering_location    = "CDC-Canberra2"
-  bandwidth_in_gbps   = 1
-  encapsulation       = "Dot1Q"
-  identity {
-    type         = "UserAssigned"
-    identity_ids = [azurerm_user_assigned_identity.test.id]
-  }
-  link1 {
-    macsec_cipher                 = "GcmAes256"
-    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
-    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
-  }
-  link2 {
-    macsec_cipher                 = "GcmAes128"
-    macsec_ckn_keyvault_secret_id = azurerm_key_vault_secret.ckn.id
-    macsec_cak_keyvault_secret_id = azurerm_key_vault_secret.cak.id
-  }
-}
 `, template, data.RandomIntOfLength(8))
 }
 
@@ -26,7 +26,9 @@ func TestAccNetworkDDoSProtectionPlan(t *testing.T) {
 			"disappears":     testAccNetworkDDoSProtectionPlan_disappears,
 		},
 		"datasource": {
-			"basic": testAccNetworkDDoSProtectionPlanDataSource_basic,
+			"basic":                            testAccNetworkDDoSProtectionPlanDataSource_basic,
+			"publicIPsVirtualNetworkInherited": testAccDataSourcePublicIPs_ddosProtectionVirtualNetworkInherited,
+			"publicIPsBastionVirtualNetworkInherited": testAccDataSourcePublicIPs_ddosProtectionBastionVirtualNetworkInherited,
 		},
 	}
 
@@ -0,0 +1,238 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveSecurityRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"network_security_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"network_interface_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"source_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"destination_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveSecurityRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing the effective Network Security Groups for %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the effective Network Security Groups for %s: %+v", *id, err)
+	}
+	resp, err := future.Result(*client)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving the effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("network_security_group", flattenNetworkInterfaceEffectiveNetworkSecurityGroups(resp.Value)); err != nil {
+		return fmt.Errorf("setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		networkSecurityGroupId := ""
+		if group.NetworkSecurityGroup != nil {
+			networkSecurityGroupId = utils.NormalizeNilableString(group.NetworkSecurityGroup.ID)
+		}
+
+		subnetId := ""
+		networkInterfaceId := ""
+		if association := group.Association; association != nil {
+			if association.Subnet != nil {
+				subnetId = utils.NormalizeNilableString(association.Subnet.ID)
+			}
+			if association.NetworkInterface != nil {
+				networkInterfaceId = utils.NormalizeNilableString(association.NetworkInterface.ID)
+			}
+		}
+
+		rules := make([]interface{}, 0)
+		if group.EffectiveSecurityRules != nil {
+			for _, rule := range *group.EffectiveSecurityRules {
+				rules = append(rules, map[string]interface{}{
+					"name":                                  utils.NormalizeNilableString(rule.Name),
+					"priority":                              int(utils.NormaliseNilableInt32(rule.Priority)),
+					"direction":                             string(rule.Direction),
+					"access":                                string(rule.Access),
+					"protocol":                              string(rule.Protocol),
+					"source_address_prefixes":               flattenEffectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes),
+					"source_port_ranges":                    flattenEffectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges),
+					"destination_address_prefixes":          flattenEffectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes),
+					"destination_port_ranges":               flattenEffectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges),
+					"expanded_source_address_prefixes":      utils.FlattenStringSlice(rule.ExpandedSourceAddressPrefix),
+					"expanded_destination_address_prefixes": utils.FlattenStringSlice(rule.ExpandedDestinationAddressPrefix),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                   networkSecurityGroupId,
+			"subnet_id":            subnetId,
+			"network_interface_id": networkInterfaceId,
+			"security_rule":        rules,
+		})
+	}
+
+	return results
+}
+
+// flattenEffectiveSecurityRuleValues combines the singular and plural forms of a field, since the API returns
+// either depending on how the rule was defined
+func flattenEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	results := make([]interface{}, 0)
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+	if multiple != nil {
+		for _, v := range *multiple {
+			results = append(results, v)
+		}
+	}
+	return results
+}
@@ -0,0 +1,344 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerAdminRuleModel struct {
+	Name                  string                   `tfschema:"name"`
+	AdminRuleCollectionId string                   `tfschema:"admin_rule_collection_id"`
+	Action                string                   `tfschema:"action"`
+	Description           string                   `tfschema:"description"`
+	Destination           []AddressPrefixItemModel `tfschema:"destination"`
+	DestinationPortRanges []string                 `tfschema:"destination_port_ranges"`
+	Direction             string                   `tfschema:"direction"`
+	Priority              int64                    `tfschema:"priority"`
+	Protocol              string                   `tfschema:"protocol"`
+	Source                []AddressPrefixItemModel `tfschema:"source"`
+	SourcePortRanges      []string                 `tfschema:"source_port_ranges"`
+}
+
+type AddressPrefixItemModel struct {
+	AddressPrefix     string `tfschema:"address_prefix"`
+	AddressPrefixType string `tfschema:"address_prefix_type"`
+}
+
+type ManagerAdminRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+
+func (r ManagerAdminRuleResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule"
+}
+
+func (r ManagerAdminRuleResource) ModelObject() interface{} {
+	return &ManagerAdminRuleModel{}
+}
+
+func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrules.ValidateRuleID
+}
+
+func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"admin_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: adminrulecollections.ValidateRuleCollectionID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleAccess(), false),
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4096),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination": networkManagerAddressPrefixItemSchema(),
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source": networkManagerAddressPrefixItemSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			collectionId, err := adminrulecollections.ParseRuleCollectionID(model.AdminRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrules.NewRuleID(collectionId.SubscriptionId, collectionId.ResourceGroupName, collectionId.NetworkManagerName, collectionId.SecurityAdminConfigurationName, collectionId.RuleCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := adminrules.AdminRule{
+				Kind:       adminrules.AdminRuleKindCustom,
+				Properties: expandNetworkManagerAdminRule(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerAdminRuleModel{
+				Name:                  id.RuleName,
+				AdminRuleCollectionId: adminrulecollections.NewRuleCollectionID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Kind != adminrules.AdminRuleKindCustom {
+					return fmt.Errorf("retrieving %s: expected `kind` to be %q but got %q", *id, string(adminrules.AdminRuleKindCustom), string(model.Kind))
+				}
+
+				if props := model.Properties; props != nil {
+					state.Action = string(props.Access)
+					state.Direction = string(props.Direction)
+					state.Priority = props.Priority
+					state.Protocol = string(props.Protocol)
+					state.Destination = flattenNetworkManagerAddressPrefixItems(props.Destinations)
+					state.DestinationPortRanges = flattenNetworkManagerPortRanges(props.DestinationPortRanges)
+					state.Source = flattenNetworkManagerAddressPrefixItems(props.Sources)
+					state.SourcePortRanges = flattenNetworkManagerPortRanges(props.SourcePortRanges)
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := adminrules.AdminRule{
+				Kind:       adminrules.AdminRuleKindCustom,
+				Properties: expandNetworkManagerAdminRule(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, adminrules.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func networkManagerAddressPrefixItemSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForAddressPrefixType(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerAdminRule(input ManagerAdminRuleModel) *adminrules.AdminPropertiesFormat {
+	output := adminrules.AdminPropertiesFormat{
+		Access:                adminrules.SecurityConfigurationRuleAccess(input.Action),
+		DestinationPortRanges: &input.DestinationPortRanges,
+		Destinations:          expandNetworkManagerAddressPrefixItems(input.Destination),
+		Direction:             adminrules.SecurityConfigurationRuleDirection(input.Direction),
+		Priority:              input.Priority,
+		Protocol:              adminrules.SecurityConfigurationRuleProtocol(input.Protocol),
+		SourcePortRanges:      &input.SourcePortRanges,
+		Sources:               expandNetworkManagerAddressPrefixItems(input.Source),
+	}
+
+	if input.Description != "" {
+		output.Description = utils.String(input.Description)
+	}
+
+	return &output
+}
+
+func expandNetworkManagerAddressPrefixItems(input []AddressPrefixItemModel) *[]adminrules.AddressPrefixItem {
+	output := make([]adminrules.AddressPrefixItem, 0)
+	for _, v := range input {
+		prefixType := adminrules.AddressPrefixType(v.AddressPrefixType)
+		output = append(output, adminrules.AddressPrefixItem{
+			AddressPrefix:     utils.String(v.AddressPrefix),
+			AddressPrefixType: &prefixType,
+		})
+	}
+	return &output
+}
+
+func flattenNetworkManagerAddressPrefixItems(input *[]adminrules.AddressPrefixItem) []AddressPrefixItemModel {
+	output := make([]AddressPrefixItemModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		item := AddressPrefixItemModel{}
+		if v.AddressPrefix != nil {
+			item.AddressPrefix = *v.AddressPrefix
+		}
+		if v.AddressPrefixType != nil {
+			item.AddressPrefixType = string(*v.AddressPrefixType)
+		}
+		output = append(output, item)
+	}
+	return output
+}
+
+func flattenNetworkManagerPortRanges(input *[]string) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+	return *input
+}
@@ -0,0 +1,304 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagercommits"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagerdeploymentstatus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerDeploymentModel struct {
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Location         string            `tfschema:"location"`
+	ScopeAccess      string            `tfschema:"scope_access"`
+	ConfigurationIds []string          `tfschema:"configuration_ids"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+type ManagerDeploymentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerDeploymentResource{}
+
+func (r ManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentResource) ModelObject() interface{} {
+	return &ManagerDeploymentModel{}
+}
+
+func (r ManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerDeploymentID
+}
+
+func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+
+		"scope_access": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagercommits.PossibleValuesForConfigurationType(), false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerDeploymentID(*networkManagerId, location.Normalize(model.Location), model.ScopeAccess)
+
+			existing, err := findNetworkManagerDeployment(ctx, metadata.Client.Network.ManagerDeploymentStatusClient, id)
+			if err != nil {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := commitNetworkManagerDeployment(ctx, metadata, id, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			deployment, err := findNetworkManagerDeployment(ctx, metadata.Client.Network.ManagerDeploymentStatusClient, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if deployment == nil || deployment.ConfigurationIds == nil || len(*deployment.ConfigurationIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ManagerDeploymentModel{
+				NetworkManagerId: id.NetworkManager.ID(),
+				Location:         location.Normalize(id.Location),
+				ScopeAccess:      id.ScopeAccess,
+				ConfigurationIds: *deployment.ConfigurationIds,
+				Triggers:         model.Triggers,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := commitNetworkManagerDeployment(ctx, metadata, *id, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// committing an empty set of configurations removes the deployment from the region
+			if err := commitNetworkManagerDeployment(ctx, metadata, *id, []string{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commitNetworkManagerDeployment(ctx context.Context, metadata sdk.ResourceMetaData, id parse.NetworkManagerDeploymentId, configurationIds []string) error {
+	client := metadata.Client.Network.ManagerCommitsClient
+
+	commitId := networkmanagercommits.NewNetworkManagerID(id.NetworkManager.SubscriptionId, id.NetworkManager.ResourceGroupName, id.NetworkManager.NetworkManagerName)
+	input := networkmanagercommits.NetworkManagerCommit{
+		CommitType:       networkmanagercommits.ConfigurationType(id.ScopeAccess),
+		ConfigurationIds: &configurationIds,
+		TargetLocations:  []string{id.Location},
+	}
+
+	if err := client.PostThenPoll(ctx, commitId, input); err != nil {
+		return fmt.Errorf("committing: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(networkmanagerdeploymentstatus.DeploymentStatusNotStarted),
+			string(networkmanagerdeploymentstatus.DeploymentStatusDeploying),
+		},
+		Target:     []string{string(networkmanagerdeploymentstatus.DeploymentStatusDeployed)},
+		Refresh:    networkManagerDeploymentStateRefreshFunc(ctx, metadata.Client.Network.ManagerDeploymentStatusClient, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentStateRefreshFunc(ctx context.Context, client *networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := findNetworkManagerDeployment(ctx, client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deployment == nil || deployment.DeploymentStatus == nil {
+			return deployment, string(networkmanagerdeploymentstatus.DeploymentStatusNotStarted), nil
+		}
+
+		status := *deployment.DeploymentStatus
+		if status == networkmanagerdeploymentstatus.DeploymentStatusFailed {
+			message := ""
+			if deployment.ErrorMessage != nil {
+				message = *deployment.ErrorMessage
+			}
+			return deployment, string(status), fmt.Errorf("deployment failed: %s", message)
+		}
+
+		return deployment, string(status), nil
+	}
+}
+
+func findNetworkManagerDeployment(ctx context.Context, client *networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId) (*networkmanagerdeploymentstatus.NetworkManagerDeploymentStatus, error) {
+	statusId := networkmanagerdeploymentstatus.NewNetworkManagerID(id.NetworkManager.SubscriptionId, id.NetworkManager.ResourceGroupName, id.NetworkManager.NetworkManagerName)
+	input := networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagerdeploymentstatus.ConfigurationType{
+			networkmanagerdeploymentstatus.ConfigurationType(id.ScopeAccess),
+		},
+		Regions: &[]string{id.Location},
+	}
+
+	for {
+		resp, err := client.List(ctx, statusId, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing deployment status: %+v", err)
+		}
+
+		if resp.Model == nil {
+			return nil, nil
+		}
+
+		if resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				if v.Region == nil || !strings.EqualFold(location.Normalize(*v.Region), location.Normalize(id.Location)) {
+					continue
+				}
+				if v.DeploymentType == nil || !strings.EqualFold(string(*v.DeploymentType), id.ScopeAccess) {
+					continue
+				}
+
+				deployment := v
+				return &deployment, nil
+			}
+		}
+
+		if resp.Model.SkipToken == nil || *resp.Model.SkipToken == "" {
+			return nil, nil
+		}
+		input.SkipToken = resp.Model.SkipToken
+	}
+}
@@ -173,6 +173,8 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkSecurityGroupCustomizeDiff),
 	}
 }
 
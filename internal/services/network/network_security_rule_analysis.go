@@ -0,0 +1,404 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// securityRuleDefinition is a normalized representation of a Network Security Rule, whether it's
+// defined inline within a Network Security Group, as a standalone resource or retrieved from the API
+type securityRuleDefinition struct {
+	Name      string
+	Priority  int
+	Direction string
+	Access    string
+	Protocol  string
+
+	SourceAddressPrefixes             []string
+	SourcePortRanges                  []string
+	SourceApplicationSecurityGroupIds []string
+
+	DestinationAddressPrefixes             []string
+	DestinationPortRanges                  []string
+	DestinationApplicationSecurityGroupIds []string
+}
+
+// securityRuleIssue is a problem found when analysing a set of Network Security Rules - errors are conditions
+// which the API will reject, whereas warnings are valid but likely unintended configurations
+type securityRuleIssue struct {
+	IsError bool
+	Message string
+
+	// RuleNames are the names of the Security Rules involved in this issue
+	RuleNames []string
+}
+
+// analyseSecurityRules checks the specified Network Security Rules for duplicate priorities, rules which are
+// fully shadowed by a rule with a higher priority and overly broad inbound allow rules
+func analyseSecurityRules(rules []securityRuleDefinition) []securityRuleIssue {
+	output := make([]securityRuleIssue, 0)
+
+	for i, rule := range rules {
+		for j, other := range rules {
+			if i == j || !strings.EqualFold(rule.Direction, other.Direction) {
+				continue
+			}
+
+			// only report duplicate priorities once per pair of rules
+			if rule.Priority == other.Priority && i < j {
+				output = append(output, securityRuleIssue{
+					IsError:   true,
+					Message:   fmt.Sprintf("the %s Security Rules %q and %q both have the priority %d - priorities must be unique within each direction", rule.Direction, rule.Name, other.Name, rule.Priority),
+					RuleNames: []string{rule.Name, other.Name},
+				})
+			}
+
+			if other.Priority < rule.Priority && securityRuleCovers(other, rule) {
+				output = append(output, securityRuleIssue{
+					Message:   fmt.Sprintf("the %s Security Rule %q (priority %d) is shadowed by the Security Rule %q (priority %d) and will never be evaluated", rule.Direction, rule.Name, rule.Priority, other.Name, other.Priority),
+					RuleNames: []string{rule.Name, other.Name},
+				})
+			}
+		}
+
+		if securityRuleIsOverlyBroadAllow(rule) {
+			output = append(output, securityRuleIssue{
+				Message:   fmt.Sprintf("the Inbound Security Rule %q (priority %d) allows traffic on all ports from any source - consider restricting the `destination_port_range` or `source_address_prefix`", rule.Name, rule.Priority),
+				RuleNames: []string{rule.Name},
+			})
+		}
+	}
+
+	return output
+}
+
+// securityRuleCovers returns whether all traffic matched by `rule` is also matched by `other`
+func securityRuleCovers(other securityRuleDefinition, rule securityRuleDefinition) bool {
+	if other.Protocol != string(network.SecurityRuleProtocolAsterisk) && !strings.EqualFold(other.Protocol, rule.Protocol) {
+		return false
+	}
+
+	return securityRuleEndpointCovers(other.SourceAddressPrefixes, other.SourceApplicationSecurityGroupIds, rule.SourceAddressPrefixes, rule.SourceApplicationSecurityGroupIds) &&
+		securityRuleEndpointCovers(other.DestinationAddressPrefixes, other.DestinationApplicationSecurityGroupIds, rule.DestinationAddressPrefixes, rule.DestinationApplicationSecurityGroupIds) &&
+		securityRulePortRangesCover(other.SourcePortRanges, rule.SourcePortRanges) &&
+		securityRulePortRangesCover(other.DestinationPortRanges, rule.DestinationPortRanges)
+}
+
+func securityRuleEndpointCovers(otherPrefixes, otherAsgIds, prefixes, asgIds []string) bool {
+	if len(asgIds) > 0 {
+		// Application Security Groups are only covered by a wildcard or by the same Application Security Groups
+		if len(otherAsgIds) == 0 {
+			return securityRuleContainsWildcard(otherPrefixes)
+		}
+		for _, v := range asgIds {
+			if !utils.SliceContainsValue(otherAsgIds, v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if len(otherAsgIds) > 0 || len(prefixes) == 0 {
+		return false
+	}
+
+	for _, prefix := range prefixes {
+		covered := false
+		for _, otherPrefix := range otherPrefixes {
+			if securityRuleAddressPrefixCovers(otherPrefix, prefix) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+func securityRuleAddressPrefixCovers(other string, prefix string) bool {
+	if other == "*" || strings.EqualFold(other, prefix) {
+		return true
+	}
+
+	otherNetwork := parseSecurityRuleAddressPrefix(other)
+	prefixNetwork := parseSecurityRuleAddressPrefix(prefix)
+	if otherNetwork == nil || prefixNetwork == nil {
+		// service tags can only be compared by name
+		return false
+	}
+
+	otherOnes, _ := otherNetwork.Mask.Size()
+	ones, _ := prefixNetwork.Mask.Size()
+	return otherOnes <= ones && otherNetwork.Contains(prefixNetwork.IP)
+}
+
+// parseSecurityRuleAddressPrefix parses an IPv4 address or CIDR, returning nil for service tags and
+// other values which can't be compared numerically
+func parseSecurityRuleAddressPrefix(input string) *net.IPNet {
+	if _, errors := validate.CIDR(input, "address_prefix"); len(errors) > 0 {
+		return nil
+	}
+
+	if !strings.Contains(input, "/") {
+		input += "/32"
+	}
+
+	_, output, err := net.ParseCIDR(input)
+	if err != nil {
+		return nil
+	}
+	return output
+}
+
+func securityRulePortRangesCover(otherRanges []string, ranges []string) bool {
+	if securityRuleContainsWildcard(otherRanges) {
+		return true
+	}
+	if len(ranges) == 0 {
+		return false
+	}
+
+	for _, v := range ranges {
+		start, end, ok := parseSecurityRulePortRange(v)
+		if !ok {
+			return false
+		}
+
+		covered := false
+		for _, other := range otherRanges {
+			otherStart, otherEnd, ok := parseSecurityRulePortRange(other)
+			if ok && otherStart <= start && end <= otherEnd {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseSecurityRulePortRange parses a port (`80`) or port range (`1024-2048`), returning false for
+// wildcards and invalid values
+func parseSecurityRulePortRange(input string) (int, int, bool) {
+	if _, errors := validate.PortOrPortRangeWithin(0, 65535)(input, "port_range"); len(errors) > 0 {
+		return 0, 0, false
+	}
+
+	segments := strings.Split(input, "-")
+	start, _ := strconv.Atoi(segments[0])
+	end := start
+	if len(segments) == 2 {
+		end, _ = strconv.Atoi(segments[1])
+	}
+
+	return start, end, true
+}
+
+func securityRuleIsOverlyBroadAllow(rule securityRuleDefinition) bool {
+	if !strings.EqualFold(rule.Access, string(network.SecurityRuleAccessAllow)) || !strings.EqualFold(rule.Direction, string(network.SecurityRuleDirectionInbound)) {
+		return false
+	}
+	if len(rule.SourceApplicationSecurityGroupIds) > 0 || !securityRuleContainsWildcard(rule.DestinationPortRanges) {
+		return false
+	}
+
+	for _, v := range rule.SourceAddressPrefixes {
+		if v == "*" || v == "0.0.0.0/0" || strings.EqualFold(v, "Internet") || strings.EqualFold(v, "Any") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func securityRuleContainsWildcard(input []string) bool {
+	return utils.SliceContainsValue(input, "*")
+}
+
+func securityRuleValues(single string, multiple *pluginsdk.Set) []string {
+	output := make([]string, 0)
+	if single != "" {
+		output = append(output, single)
+	}
+	if multiple != nil {
+		for _, v := range multiple.List() {
+			output = append(output, v.(string))
+		}
+	}
+	return output
+}
+
+func securityRuleValuesFromAPI(single *string, multiple *[]string) []string {
+	output := make([]string, 0)
+	if single != nil && *single != "" {
+		output = append(output, *single)
+	}
+	if multiple != nil {
+		output = append(output, *multiple...)
+	}
+	return output
+}
+
+func expandSecurityRuleDefinition(input map[string]interface{}) securityRuleDefinition {
+	setOrNil := func(key string) *pluginsdk.Set {
+		if v, ok := input[key].(*pluginsdk.Set); ok {
+			return v
+		}
+		return nil
+	}
+
+	return securityRuleDefinition{
+		Name:                                   input["name"].(string),
+		Priority:                               input["priority"].(int),
+		Direction:                              input["direction"].(string),
+		Access:                                 input["access"].(string),
+		Protocol:                               input["protocol"].(string),
+		SourceAddressPrefixes:                  securityRuleValues(input["source_address_prefix"].(string), setOrNil("source_address_prefixes")),
+		SourcePortRanges:                       securityRuleValues(input["source_port_range"].(string), setOrNil("source_port_ranges")),
+		SourceApplicationSecurityGroupIds:      securityRuleValues("", setOrNil("source_application_security_group_ids")),
+		DestinationAddressPrefixes:             securityRuleValues(input["destination_address_prefix"].(string), setOrNil("destination_address_prefixes")),
+		DestinationPortRanges:                  securityRuleValues(input["destination_port_range"].(string), setOrNil("destination_port_ranges")),
+		DestinationApplicationSecurityGroupIds: securityRuleValues("", setOrNil("destination_application_security_group_ids")),
+	}
+}
+
+func flattenSecurityRuleDefinition(input network.SecurityRule) *securityRuleDefinition {
+	props := input.SecurityRulePropertiesFormat
+	if input.Name == nil || props == nil || props.Priority == nil {
+		return nil
+	}
+
+	return &securityRuleDefinition{
+		Name:                                   *input.Name,
+		Priority:                               int(*props.Priority),
+		Direction:                              string(props.Direction),
+		Access:                                 string(props.Access),
+		Protocol:                               string(props.Protocol),
+		SourceAddressPrefixes:                  securityRuleValuesFromAPI(props.SourceAddressPrefix, props.SourceAddressPrefixes),
+		SourcePortRanges:                       securityRuleValuesFromAPI(props.SourcePortRange, props.SourcePortRanges),
+		SourceApplicationSecurityGroupIds:      flattenApplicationSecurityGroupIds(props.SourceApplicationSecurityGroups),
+		DestinationAddressPrefixes:             securityRuleValuesFromAPI(props.DestinationAddressPrefix, props.DestinationAddressPrefixes),
+		DestinationPortRanges:                  securityRuleValuesFromAPI(props.DestinationPortRange, props.DestinationPortRanges),
+		DestinationApplicationSecurityGroupIds: flattenApplicationSecurityGroupIds(props.DestinationApplicationSecurityGroups),
+	}
+}
+
+// reportSecurityRuleIssues logs any warnings (which are only visible in the Terraform logs) and returns an error containing
+// any errors found during the analysis, warnings are also included in the error when `fail_on_security_rule_warnings`
+// is enabled in the features block
+func reportSecurityRuleIssues(resourceName string, issues []securityRuleIssue, failOnWarnings bool) error {
+	messages := make([]string, 0)
+	for _, v := range issues {
+		if !v.IsError && !failOnWarnings {
+			log.Printf("[WARN] %s: %s", resourceName, v.Message)
+			continue
+		}
+		messages = append(messages, v.Message)
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("validating the Security Rules for %s:\n\n%s", resourceName, strings.Join(messages, "\n"))
+}
+
+func networkSecurityGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// the rules can't be analysed until their values are known
+	if !d.NewValueKnown("security_rule") {
+		return nil
+	}
+
+	rules := make([]securityRuleDefinition, 0)
+	for _, v := range d.Get("security_rule").(*pluginsdk.Set).List() {
+		raw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule := expandSecurityRuleDefinition(raw)
+		if rule.Priority == 0 {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	failOnWarnings := meta.(*clients.Client).Features.NetworkSecurityGroup.FailOnSecurityRuleWarnings
+	return reportSecurityRuleIssues(fmt.Sprintf("Network Security Group %q", d.Get("name").(string)), analyseSecurityRules(rules), failOnWarnings)
+}
+
+func networkSecurityRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"name", "resource_group_name", "network_security_group_name", "priority", "direction"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	rule := securityRuleDefinition{
+		Name:                                   d.Get("name").(string),
+		Priority:                               d.Get("priority").(int),
+		Direction:                              d.Get("direction").(string),
+		Access:                                 d.Get("access").(string),
+		Protocol:                               d.Get("protocol").(string),
+		SourceAddressPrefixes:                  securityRuleValues(d.Get("source_address_prefix").(string), d.Get("source_address_prefixes").(*pluginsdk.Set)),
+		SourcePortRanges:                       securityRuleValues(d.Get("source_port_range").(string), d.Get("source_port_ranges").(*pluginsdk.Set)),
+		SourceApplicationSecurityGroupIds:      securityRuleValues("", d.Get("source_application_security_group_ids").(*pluginsdk.Set)),
+		DestinationAddressPrefixes:             securityRuleValues(d.Get("destination_address_prefix").(string), d.Get("destination_address_prefixes").(*pluginsdk.Set)),
+		DestinationPortRanges:                  securityRuleValues(d.Get("destination_port_range").(string), d.Get("destination_port_ranges").(*pluginsdk.Set)),
+		DestinationApplicationSecurityGroupIds: securityRuleValues("", d.Get("destination_application_security_group_ids").(*pluginsdk.Set)),
+	}
+	rules := []securityRuleDefinition{rule}
+
+	// when the rule is being renamed (which forces a new resource) the rule with the previous name is deleted
+	// before this rule is created, so it mustn't be considered a conflict
+	oldName, _ := d.GetChange("name")
+
+	// the other rules within the Network Security Group are retrieved from the API when it already exists
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupName := d.Get("network_security_group_name").(string)
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	existing, err := client.Get(ctx, resourceGroup, networkSecurityGroupName, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("retrieving Network Security Group %q (Resource Group %q): %+v", networkSecurityGroupName, resourceGroup, err)
+		}
+	}
+	if props := existing.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, v := range *props.SecurityRules {
+			other := flattenSecurityRuleDefinition(v)
+			if other == nil || strings.EqualFold(other.Name, rule.Name) || strings.EqualFold(other.Name, oldName.(string)) {
+				continue
+			}
+			rules = append(rules, *other)
+		}
+	}
+
+	// only issues involving this rule are reported, since the other rules are managed elsewhere - and since the other
+	// rules may be changed within the same plan (for example when priorities are being swapped) any conflicts with
+	// them are only raised as warnings
+	issues := make([]securityRuleIssue, 0)
+	for _, v := range analyseSecurityRules(rules) {
+		if utils.SliceContainsValue(v.RuleNames, rule.Name) {
+			v.IsError = false
+			issues = append(issues, v)
+		}
+	}
+
+	failOnWarnings := meta.(*clients.Client).Features.NetworkSecurityGroup.FailOnSecurityRuleWarnings
+	return reportSecurityRuleIssues(fmt.Sprintf("Network Security Rule %q", rule.Name), issues, failOnWarnings)
+}
@@ -0,0 +1,200 @@
+package network
+
+import (
+	"testing"
+)
+
+func TestAnalyseSecurityRules(t *testing.T) {
+	rule := func(name string, priority int, access, protocol, source, sourcePorts, destination, destinationPorts string) securityRuleDefinition {
+		return securityRuleDefinition{
+			Name:                       name,
+			Priority:                   priority,
+			Direction:                  "Inbound",
+			Access:                     access,
+			Protocol:                   protocol,
+			SourceAddressPrefixes:      []string{source},
+			SourcePortRanges:           []string{sourcePorts},
+			DestinationAddressPrefixes: []string{destination},
+			DestinationPortRanges:      []string{destinationPorts},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Rules    []securityRuleDefinition
+		Errors   int
+		Warnings int
+	}{
+		{
+			Name: "Distinct Rules",
+			Rules: []securityRuleDefinition{
+				rule("https", 100, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "443"),
+				rule("ssh", 110, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "22"),
+			},
+		},
+		{
+			Name: "Duplicate Priority",
+			Rules: []securityRuleDefinition{
+				rule("https", 100, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "443"),
+				rule("ssh", 100, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "22"),
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Duplicate Priority in Different Directions",
+			Rules: []securityRuleDefinition{
+				rule("https", 100, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "443"),
+				func() securityRuleDefinition {
+					v := rule("ssh", 100, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "22")
+					v.Direction = "Outbound"
+					return v
+				}(),
+			},
+		},
+		{
+			Name: "Shadowed by Containing CIDR and Port Range",
+			Rules: []securityRuleDefinition{
+				rule("broad", 100, "Deny", "*", "10.0.0.0/8", "*", "*", "1-1024"),
+				rule("narrow", 200, "Allow", "Tcp", "10.1.2.0/24", "*", "*", "443"),
+			},
+			Warnings: 1,
+		},
+		{
+			Name: "Not Shadowed when the Lower Priority Rule is Broader",
+			Rules: []securityRuleDefinition{
+				rule("narrow", 100, "Allow", "Tcp", "10.1.2.0/24", "*", "*", "443"),
+				rule("broad", 200, "Deny", "*", "10.0.0.0/8", "*", "*", "1-1024"),
+			},
+		},
+		{
+			Name: "Not Shadowed by a Different Protocol",
+			Rules: []securityRuleDefinition{
+				rule("udp", 100, "Deny", "Udp", "*", "*", "*", "53"),
+				rule("tcp", 200, "Allow", "Tcp", "*", "*", "*", "53"),
+			},
+		},
+		{
+			Name: "Service Tags Compared by Name",
+			Rules: []securityRuleDefinition{
+				rule("lb", 100, "Allow", "*", "AzureLoadBalancer", "*", "*", "80"),
+				rule("lb-again", 200, "Allow", "Tcp", "AzureLoadBalancer", "*", "VirtualNetwork", "80"),
+				rule("vnet", 300, "Allow", "Tcp", "VirtualNetwork", "*", "*", "80"),
+			},
+			Warnings: 1,
+		},
+		{
+			Name: "Overly Broad Allow from the Internet",
+			Rules: []securityRuleDefinition{
+				rule("any", 100, "Allow", "*", "Internet", "*", "*", "*"),
+			},
+			Warnings: 1,
+		},
+		{
+			Name: "Broad Deny is Fine",
+			Rules: []securityRuleDefinition{
+				rule("deny-all", 4096, "Deny", "*", "*", "*", "*", "*"),
+			},
+		},
+		{
+			Name: "Application Security Groups",
+			Rules: []securityRuleDefinition{
+				func() securityRuleDefinition {
+					v := rule("asg", 100, "Allow", "Tcp", "", "*", "*", "443")
+					v.SourceAddressPrefixes = []string{}
+					v.SourceApplicationSecurityGroupIds = []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/asg1"}
+					return v
+				}(),
+				rule("cidr", 200, "Allow", "Tcp", "10.0.0.0/16", "*", "*", "443"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		errors, warnings := 0, 0
+		for _, issue := range analyseSecurityRules(v.Rules) {
+			if issue.IsError {
+				errors++
+			} else {
+				warnings++
+			}
+		}
+
+		if errors != v.Errors {
+			t.Fatalf("expected %d errors but got %d for %q", v.Errors, errors, v.Name)
+		}
+		if warnings != v.Warnings {
+			t.Fatalf("expected %d warnings but got %d for %q", v.Warnings, warnings, v.Name)
+		}
+	}
+}
+
+func TestReportSecurityRuleIssues(t *testing.T) {
+	warning := securityRuleIssue{Message: "shadowed"}
+	failure := securityRuleIssue{IsError: true, Message: "duplicate priority"}
+
+	testData := []struct {
+		Name           string
+		Issues         []securityRuleIssue
+		FailOnWarnings bool
+		Error          bool
+	}{
+		{
+			Name:   "No Issues",
+			Issues: []securityRuleIssue{},
+		},
+		{
+			Name:   "Warning",
+			Issues: []securityRuleIssue{warning},
+		},
+		{
+			Name:           "Warning when failing on Warnings",
+			Issues:         []securityRuleIssue{warning},
+			FailOnWarnings: true,
+			Error:          true,
+		},
+		{
+			Name:   "Error",
+			Issues: []securityRuleIssue{warning, failure},
+			Error:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := reportSecurityRuleIssues("Network Security Group \"example\"", v.Issues, v.FailOnWarnings)
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one for %q", v.Name)
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got %+v for %q", err, v.Name)
+		}
+	}
+}
+
+func TestSecurityRuleAddressPrefixCovers(t *testing.T) {
+	testData := []struct {
+		Other    string
+		Prefix   string
+		Expected bool
+	}{
+		{Other: "*", Prefix: "10.0.0.0/8", Expected: true},
+		{Other: "10.0.0.0/8", Prefix: "10.0.0.0/8", Expected: true},
+		{Other: "10.0.0.0/8", Prefix: "10.1.0.0/16", Expected: true},
+		{Other: "10.0.0.0/8", Prefix: "10.1.2.3", Expected: true},
+		{Other: "10.1.0.0/16", Prefix: "10.0.0.0/8", Expected: false},
+		{Other: "10.0.0.0/8", Prefix: "192.168.0.0/16", Expected: false},
+		{Other: "Internet", Prefix: "internet", Expected: true},
+		{Other: "VirtualNetwork", Prefix: "10.0.0.0/8", Expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q covers %q", v.Other, v.Prefix)
+
+		if actual := securityRuleAddressPrefixCovers(v.Other, v.Prefix); actual != v.Expected {
+			t.Fatalf("expected %t but got %t for %q covering %q", v.Expected, actual, v.Other, v.Prefix)
+		}
+	}
+}
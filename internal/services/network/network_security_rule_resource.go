@@ -165,6 +165,8 @@ func resourceNetworkSecurityRule() *pluginsdk.Resource {
 				}, false),
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkSecurityRuleCustomizeDiff),
 	}
 }
 
@@ -0,0 +1,292 @@
+package network
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherConnectivityCheck() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherConnectivityCheckRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: azValidate.PortNumberOrZero,
+						},
+					},
+				},
+			},
+
+			"destination": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+							ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+						},
+
+						"address": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: azValidate.PortNumberOrZero,
+						},
+					},
+				},
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.ProtocolTCP),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolTCP),
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+					string(network.ProtocolIcmp),
+				}, false),
+			},
+
+			"preferred_ip_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPVersionIPv4),
+					string(network.IPVersionIPv6),
+				}, false),
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"average_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"minimum_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"maximum_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_sent": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_failed": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"hop": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"issue": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"origin": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"severity": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherConnectivityCheckRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	source := d.Get("source").([]interface{})[0].(map[string]interface{})
+	destination := d.Get("destination").([]interface{})[0].(map[string]interface{})
+
+	parameters := network.ConnectivityParameters{
+		Source: &network.ConnectivitySource{
+			ResourceID: utils.String(source["resource_id"].(string)),
+		},
+		Destination: &network.ConnectivityDestination{},
+		Protocol:    network.Protocol(d.Get("protocol").(string)),
+	}
+	if v := source["port"].(int); v != 0 {
+		parameters.Source.Port = utils.Int32(int32(v))
+	}
+	if v := destination["resource_id"].(string); v != "" {
+		parameters.Destination.ResourceID = utils.String(v)
+	}
+	if v := destination["address"].(string); v != "" {
+		parameters.Destination.Address = utils.String(v)
+	}
+	if v := destination["port"].(int); v != 0 {
+		parameters.Destination.Port = utils.Int32(int32(v))
+	}
+	if v := d.Get("preferred_ip_version").(string); v != "" {
+		parameters.PreferredIPVersion = network.IPVersion(v)
+	}
+
+	future, err := client.CheckConnectivity(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("checking connectivity using %s: %+v", *watcherId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the connectivity check using %s: %+v", *watcherId, err)
+	}
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the result of the connectivity check using %s: %+v", *watcherId, err)
+	}
+
+	id := fmt.Sprintf("%s/connectivityCheck/source=%s:%d;destination=%s%s:%d;protocol=%s", watcherId.ID(), source["resource_id"].(string), source["port"].(int), destination["resource_id"].(string), destination["address"].(string), destination["port"].(int), d.Get("protocol").(string))
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(id)))
+
+	d.Set("connection_status", string(resp.ConnectionStatus))
+	d.Set("average_latency_in_ms", int(utils.NormaliseNilableInt32(resp.AvgLatencyInMs)))
+	d.Set("minimum_latency_in_ms", int(utils.NormaliseNilableInt32(resp.MinLatencyInMs)))
+	d.Set("maximum_latency_in_ms", int(utils.NormaliseNilableInt32(resp.MaxLatencyInMs)))
+	d.Set("probes_sent", int(utils.NormaliseNilableInt32(resp.ProbesSent)))
+	d.Set("probes_failed", int(utils.NormaliseNilableInt32(resp.ProbesFailed)))
+
+	if err := d.Set("hop", flattenNetworkWatcherConnectivityHops(resp.Hops)); err != nil {
+		return fmt.Errorf("setting `hop`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkWatcherConnectivityHops(input *[]network.ConnectivityHop) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, hop := range *input {
+		issues := make([]interface{}, 0)
+		if hop.Issues != nil {
+			for _, issue := range *hop.Issues {
+				issues = append(issues, map[string]interface{}{
+					"origin":   string(issue.Origin),
+					"severity": string(issue.Severity),
+					"type":     string(issue.Type),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":           utils.NormalizeNilableString(hop.ID),
+			"type":         utils.NormalizeNilableString(hop.Type),
+			"address":      utils.NormalizeNilableString(hop.Address),
+			"resource_id":  utils.NormalizeNilableString(hop.ResourceID),
+			"next_hop_ids": utils.FlattenStringSlice(hop.NextHopIds),
+			"issue":        issues,
+		})
+	}
+
+	return results
+}
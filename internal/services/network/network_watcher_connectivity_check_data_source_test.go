@@ -0,0 +1,49 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherConnectivityCheckDataSource struct{}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_address(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.address(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("connection_status").Exists(),
+				check.That(data.ResourceName).Key("probes_sent").Exists(),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) address(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+
+  source {
+    resource_id = azurerm_virtual_machine.test.id
+  }
+
+  destination {
+    address = "www.bing.com"
+    port    = 443
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
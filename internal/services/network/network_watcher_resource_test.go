@@ -33,7 +33,10 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"disappears":     testAccNetworkWatcher_disappears,
 		},
 		"DataSource": {
-			"basic": testAccDataSourceNetworkWatcher_basic,
+			"basic":                    testAccDataSourceNetworkWatcher_basic,
+			"connectivityCheckAddress": testAccDataSourceNetworkWatcherConnectivityCheck_address,
+			"ipFlowVerify":             testAccDataSourceNetworkWatcherIpFlowVerify_basic,
+			"nextHop":                  testAccDataSourceNetworkWatcherNextHop_basic,
 		},
 		"ConnectionMonitor": {
 			"addressBasic":                   testAccNetworkConnectionMonitor_addressBasic,
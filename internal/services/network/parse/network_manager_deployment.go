@@ -0,0 +1,66 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+)
+
+type NetworkManagerDeploymentId struct {
+	NetworkManager networkmanagers.NetworkManagerId
+	Location       string
+	ScopeAccess    string
+}
+
+func NewNetworkManagerDeploymentID(networkManagerId networkmanagers.NetworkManagerId, location string, scopeAccess string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		NetworkManager: networkManagerId,
+		Location:       location,
+		ScopeAccess:    scopeAccess,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Network Manager: %q", id.NetworkManager.ID()),
+		fmt.Sprintf("Location: %q", id.Location),
+		fmt.Sprintf("Scope Access: %q", id.ScopeAccess),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Deployment", segmentsStr)
+}
+
+func (id NetworkManagerDeploymentId) ID() string {
+	return fmt.Sprintf("%s/commit|%s|%s", id.NetworkManager.ID(), id.Location, id.ScopeAccess)
+}
+
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkManagerID}/commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	if !strings.HasSuffix(segments[0], "/commit") {
+		return nil, fmt.Errorf("expected the first segment of %q to end with `/commit`", input)
+	}
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(strings.TrimSuffix(segments[0], "/commit"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Manager ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("expected a location in %q", input)
+	}
+
+	if segments[2] == "" {
+		return nil, fmt.Errorf("expected a scope access in %q", input)
+	}
+
+	return &NetworkManagerDeploymentId{
+		NetworkManager: *networkManagerId,
+		Location:       segments[1],
+		ScopeAccess:    segments[2],
+	}, nil
+}
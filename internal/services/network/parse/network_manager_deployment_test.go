@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+)
+
+func TestNetworkManagerDeploymentID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkManagerDeploymentId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Three Segments Invalid ID",
+			Input: "hello|westeurope|Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Commit Suffix",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1|westeurope|Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Missing Location",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1/commit||Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Missing Scope Access",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope|",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager Deployment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope|Connectivity",
+			Error: false,
+			Expect: &NetworkManagerDeploymentId{
+				NetworkManager: networkmanagers.NetworkManagerId{
+					SubscriptionId:     "00000000-0000-0000-0000-000000000000",
+					ResourceGroupName:  "group1",
+					NetworkManagerName: "manager1",
+				},
+				Location:    "westeurope",
+				ScopeAccess: "Connectivity",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkManagerDeploymentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got a value")
+		}
+
+		if actual.NetworkManager != v.Expect.NetworkManager {
+			t.Fatalf("Expected %+v but got %+v for Network Manager", v.Expect.NetworkManager, actual.NetworkManager)
+		}
+
+		if actual.Location != v.Expect.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expect.Location, actual.Location)
+		}
+
+		if actual.ScopeAccess != v.Expect.ScopeAccess {
+			t.Fatalf("Expected %q but got %q for Scope Access", v.Expect.ScopeAccess, actual.ScopeAccess)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPublicIPPrefixAvailableAddresses(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Prefix    string
+		Allocated []string
+		Expected  []string
+		Error     bool
+	}{
+		{
+			Name:      "Nothing Allocated",
+			Prefix:    "20.1.2.4/30",
+			Allocated: []string{},
+			Expected:  []string{"20.1.2.4", "20.1.2.5", "20.1.2.6", "20.1.2.7"},
+		},
+		{
+			Name:      "Partially Allocated",
+			Prefix:    "20.1.2.4/30",
+			Allocated: []string{"20.1.2.5", "20.1.2.7"},
+			Expected:  []string{"20.1.2.4", "20.1.2.6"},
+		},
+		{
+			Name:      "Fully Allocated",
+			Prefix:    "20.1.2.4/31",
+			Allocated: []string{"20.1.2.4", "20.1.2.5"},
+			Expected:  []string{},
+		},
+		{
+			Name:      "Crosses Octet Boundary",
+			Prefix:    "20.1.2.255/32",
+			Allocated: []string{},
+			Expected:  []string{"20.1.2.255"},
+		},
+		{
+			Name:      "IPv6",
+			Prefix:    "2603:1030:805::/126",
+			Allocated: []string{"2603:1030:805::1"},
+			Expected:  []string{"2603:1030:805::", "2603:1030:805::2", "2603:1030:805::3"},
+		},
+		{
+			Name:   "Too Large",
+			Prefix: "20.1.0.0/16",
+			Error:  true,
+		},
+		{
+			Name:   "Invalid",
+			Prefix: "not-a-prefix",
+			Error:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := publicIPPrefixAvailableAddresses(v.Prefix, v.Allocated)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
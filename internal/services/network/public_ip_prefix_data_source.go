@@ -2,6 +2,8 @@ package network
 
 import (
 	"fmt"
+	"log"
+	"net"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -48,6 +50,30 @@ func dataSourcePublicIpPrefix() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"public_ip_address_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"allocated_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"available_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"zones": commonschema.ZonesMultipleComputed(),
 
 			"tags": commonschema.TagsDataSource(),
@@ -57,6 +83,7 @@ func dataSourcePublicIpPrefix() *pluginsdk.Resource {
 
 func dataSourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	publicIPsClient := meta.(*clients.Client).Network.PublicIPsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -78,9 +105,92 @@ func dataSourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) e
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Name))
 	}
+
+	publicIPAddressIds := make([]string, 0)
+	allocatedIPAddresses := make([]string, 0)
+	availableIPAddresses := make([]string, 0)
+	allocationUnknown := false
 	if props := resp.PublicIPPrefixPropertiesFormat; props != nil {
 		d.Set("prefix_length", props.PrefixLength)
 		d.Set("ip_prefix", props.IPPrefix)
+
+		if props.PublicIPAddresses != nil {
+			for _, v := range *props.PublicIPAddresses {
+				if v.ID == nil {
+					continue
+				}
+				publicIPAddressIds = append(publicIPAddressIds, *v.ID)
+
+				publicIPId, err := parse.PublicIpAddressID(*v.ID)
+				if err != nil {
+					return err
+				}
+				// the Public IP may be in a different Subscription to the Public IP Prefix
+				client := *publicIPsClient
+				client.SubscriptionID = publicIPId.SubscriptionId
+				publicIP, err := client.Get(ctx, publicIPId.ResourceGroup, publicIPId.Name, "")
+				if err != nil {
+					log.Printf("[DEBUG] retrieving %s allocated from %s: %+v", *publicIPId, id, err)
+					allocationUnknown = true
+					continue
+				}
+				if publicIP.PublicIPAddressPropertiesFormat != nil && publicIP.PublicIPAddressPropertiesFormat.IPAddress != nil {
+					allocatedIPAddresses = append(allocatedIPAddresses, *publicIP.PublicIPAddressPropertiesFormat.IPAddress)
+				}
+			}
+		}
+
+		// when the prefix is used by a Load Balancer or NAT Gateway the entire range is in use, so no addresses are available - and
+		// when the address of an allocated Public IP can't be determined the available addresses can't be determined either
+		if props.IPPrefix != nil && props.LoadBalancerFrontendIPConfiguration == nil && props.NatGateway == nil && !allocationUnknown {
+			availableIPAddresses, err = publicIPPrefixAvailableAddresses(*props.IPPrefix, allocatedIPAddresses)
+			if err != nil {
+				return fmt.Errorf("determining the available addresses within %s: %+v", id, err)
+			}
+		}
 	}
+	d.Set("public_ip_address_ids", publicIPAddressIds)
+	d.Set("allocated_ip_addresses", allocatedIPAddresses)
+	d.Set("available_ip_addresses", availableIPAddresses)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+// publicIPPrefixAvailableAddresses returns the addresses within the prefix which haven't been allocated to a Public IP
+func publicIPPrefixAvailableAddresses(prefix string, allocated []string) ([]string, error) {
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", prefix, err)
+	}
+
+	// Public IP Prefixes are at most 16 addresses, so this guards against enumerating an unexpectedly large range
+	ones, bits := ipNet.Mask.Size()
+	if bits-ones > 8 {
+		return nil, fmt.Errorf("expected %q to contain at most 256 addresses", prefix)
+	}
+
+	used := make(map[string]struct{})
+	for _, v := range allocated {
+		if ip := net.ParseIP(v); ip != nil {
+			used[ip.String()] = struct{}{}
+		}
+	}
+
+	results := make([]string, 0)
+	current := make(net.IP, len(ipNet.IP))
+	copy(current, ipNet.IP)
+	for i := 0; i < 1<<(bits-ones); i++ {
+		if _, ok := used[current.String()]; !ok {
+			results = append(results, current.String())
+		}
+
+		for j := len(current) - 1; j >= 0; j-- {
+			current[j]++
+			if current[j] != 0 {
+				break
+			}
+		}
+	}
+
+	return results, nil
+}
@@ -26,6 +26,9 @@ func TestAccDataSourcePublicIPPrefix_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("sku").HasValue("Standard"),
 				check.That(data.ResourceName).Key("prefix_length").HasValue("31"),
 				check.That(data.ResourceName).Key("ip_prefix").Exists(),
+				check.That(data.ResourceName).Key("public_ip_address_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("allocated_ip_addresses.#").HasValue("0"),
+				check.That(data.ResourceName).Key("available_ip_addresses.#").HasValue("2"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.env").HasValue("test"),
 			),
@@ -33,6 +36,23 @@ func TestAccDataSourcePublicIPPrefix_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePublicIPPrefix_allocated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_public_ip_prefix", "test")
+	r := PublicIPPrefixDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.allocated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("public_ip_address_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("allocated_ip_addresses.#").HasValue("1"),
+				check.That(data.ResourceName).Key("allocated_ip_addresses.0").Exists(),
+				check.That(data.ResourceName).Key("available_ip_addresses.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func (PublicIPPrefixDataSource) basic(name string, resourceGroupName string, data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -66,3 +86,40 @@ data "azurerm_public_ip_prefix" "test" {
 }
 `, resourceGroupName, data.Locations.Primary, name)
 }
+
+func (PublicIPPrefixDataSource) allocated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpublicipprefix-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  prefix_length       = 31
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+  public_ip_prefix_id = azurerm_public_ip_prefix.test.id
+}
+
+data "azurerm_public_ip_prefix" "test" {
+  name                = azurerm_public_ip_prefix.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_public_ip.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
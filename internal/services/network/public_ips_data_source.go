@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
@@ -9,10 +10,13 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourcePublicIPs() *pluginsdk.Resource {
@@ -79,6 +83,14 @@ func dataSourcePublicIPSchema() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeString,
 						Computed: true,
 					},
+					"ddos_protection_mode": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"ddos_protection_plan_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -132,23 +144,22 @@ func dataSourcePublicIPsRead(d *pluginsdk.ResourceData, meta interface{}) error
 	id := fmt.Sprintf("networkPublicIPs/resourceGroup/%s/namePrefix=%s;attachmentStatus=%s;allocationType=%s", resourceGroup, prefix, attachmentStatus, allocationType)
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(id)))
 
-	results := flattenDataSourcePublicIPs(filteredIPAddresses)
-	if err := d.Set("public_ips", results); err != nil {
-		return fmt.Errorf("setting `public_ips`: %+v", err)
-	}
-
-	return nil
-}
-
-func flattenDataSourcePublicIPs(input []network.PublicIPAddress) []interface{} {
 	results := make([]interface{}, 0)
-
-	for _, element := range input {
+	virtualNetworks := make(map[string]network.VirtualNetwork)
+	for _, element := range filteredIPAddresses {
 		flattenedIPAddress := flattenDataSourcePublicIP(element)
+
+		mode, planId := publicIPEffectiveDdosProtection(ctx, meta.(*clients.Client), element, virtualNetworks)
+		flattenedIPAddress["ddos_protection_mode"] = mode
+		flattenedIPAddress["ddos_protection_plan_id"] = planId
+
 		results = append(results, flattenedIPAddress)
 	}
+	if err := d.Set("public_ips", results); err != nil {
+		return fmt.Errorf("setting `public_ips`: %+v", err)
+	}
 
-	return results
+	return nil
 }
 
 func flattenDataSourcePublicIP(input network.PublicIPAddress) map[string]string {
@@ -189,3 +200,198 @@ func flattenDataSourcePublicIP(input network.PublicIPAddress) map[string]string
 		"ip_address":        ipAddress,
 	}
 }
+
+// publicIPEffectiveDdosProtection returns the DDoS protection which applies to the Public IP, which is either configured on the
+// Public IP itself or inherited from the DDoS Protection Plan of the Virtual Network containing the resource the Public IP is
+// attached to - when the Virtual Network of that resource can't be determined (for example as it's in a Subscription which
+// can't be accessed) the DDoS protection is reported as `Unknown`
+func publicIPEffectiveDdosProtection(ctx context.Context, client *clients.Client, input network.PublicIPAddress, virtualNetworks map[string]network.VirtualNetwork) (string, string) {
+	props := input.PublicIPAddressPropertiesFormat
+	if props == nil {
+		return "Disabled", ""
+	}
+
+	if ddos := props.DdosSettings; ddos != nil {
+		if ddos.ProtectedIP != nil && *ddos.ProtectedIP {
+			return "Enabled", ""
+		}
+		if ddos.ProtectionCoverage == network.DdosSettingsProtectionCoverageStandard {
+			return "PlanLinked", ""
+		}
+	}
+
+	if props.IPConfiguration == nil || props.IPConfiguration.ID == nil {
+		if props.NatGateway != nil {
+			return "Unknown", ""
+		}
+		return "Disabled", ""
+	}
+
+	subnetId := ""
+	if config := props.IPConfiguration.IPConfigurationPropertiesFormat; config != nil && config.Subnet != nil {
+		subnetId = utils.NormalizeNilableString(config.Subnet.ID)
+	}
+
+	// the Subnet generally isn't returned for the IP Configuration, so it's looked up from the parent resource instead
+	if subnetId == "" {
+		var err error
+		subnetId, err = publicIPParentSubnetId(ctx, client, *props.IPConfiguration.ID)
+		if err != nil {
+			log.Printf("[DEBUG] determining the Subnet for the IP Configuration %q: %+v", *props.IPConfiguration.ID, err)
+			return "Unknown", ""
+		}
+	}
+
+	if subnetId == "" {
+		return "Unknown", ""
+	}
+
+	subnet, err := parse.SubnetIDInsensitively(subnetId)
+	if err != nil {
+		log.Printf("[DEBUG] parsing the Subnet ID %q: %+v", subnetId, err)
+		return "Unknown", ""
+	}
+
+	vnetId := parse.NewVirtualNetworkID(subnet.SubscriptionId, subnet.ResourceGroup, subnet.VirtualNetworkName)
+	vnet, ok := virtualNetworks[vnetId.ID()]
+	if !ok {
+		// the Virtual Network may be in a different Subscription to the Public IP
+		vnetClient := *client.Network.VnetClient
+		vnetClient.SubscriptionID = vnetId.SubscriptionId
+		vnet, err = vnetClient.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+		if err != nil {
+			log.Printf("[DEBUG] retrieving %s: %+v", vnetId, err)
+			return "Unknown", ""
+		}
+		virtualNetworks[vnetId.ID()] = vnet
+	}
+
+	if vnetProps := vnet.VirtualNetworkPropertiesFormat; vnetProps != nil && vnetProps.EnableDdosProtection != nil && *vnetProps.EnableDdosProtection {
+		if plan := vnetProps.DdosProtectionPlan; plan != nil && plan.ID != nil {
+			return "VirtualNetworkInherited", *plan.ID
+		}
+	}
+
+	return "Disabled", ""
+}
+
+// publicIPParentSubnetId returns the ID of the Subnet used by the resource which the specified IP Configuration belongs to,
+// or an empty string when the resource isn't deployed into a Subnet (e.g. a public Load Balancer) or isn't supported
+func publicIPParentSubnetId(ctx context.Context, client *clients.Client, ipConfigurationId string) (string, error) {
+	id, err := azure.ParseAzureResourceID(ipConfigurationId)
+	if err != nil {
+		return "", err
+	}
+
+	// the casing of the segments within the IP Configuration ID isn't consistent across resource types
+	segments := make(map[string]string)
+	for k, v := range id.Path {
+		segments[strings.ToLower(k)] = v
+	}
+
+	subnetId := func(input *network.SubResource) string {
+		if input == nil {
+			return ""
+		}
+		return utils.NormalizeNilableString(input.ID)
+	}
+
+	// the parent resource may be in a different Subscription to the Public IP
+	subscriptionId := id.SubscriptionID
+
+	if name, ok := segments["networkinterfaces"]; ok {
+		nicClient := *client.Network.InterfacesClient
+		nicClient.SubscriptionID = subscriptionId
+		nic, err := nicClient.Get(ctx, id.ResourceGroup, name, "")
+		if err != nil {
+			return "", fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+		}
+		if props := nic.InterfacePropertiesFormat; props != nil {
+			config := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, segments["ipconfigurations"])
+			if config != nil && config.InterfaceIPConfigurationPropertiesFormat != nil && config.InterfaceIPConfigurationPropertiesFormat.Subnet != nil {
+				return utils.NormalizeNilableString(config.InterfaceIPConfigurationPropertiesFormat.Subnet.ID), nil
+			}
+		}
+		return "", nil
+	}
+
+	if name, ok := segments["applicationgateways"]; ok {
+		gatewayClient := *client.Network.ApplicationGatewaysClient
+		gatewayClient.SubscriptionID = subscriptionId
+		gateway, err := gatewayClient.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+		}
+		if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.GatewayIPConfigurations != nil {
+			for _, config := range *props.GatewayIPConfigurations {
+				if config.ApplicationGatewayIPConfigurationPropertiesFormat != nil {
+					if v := subnetId(config.ApplicationGatewayIPConfigurationPropertiesFormat.Subnet); v != "" {
+						return v, nil
+					}
+				}
+			}
+		}
+		return "", nil
+	}
+
+	if name, ok := segments["azurefirewalls"]; ok {
+		firewallClient := *client.Firewall.AzureFirewallsClient
+		firewallClient.SubscriptionID = subscriptionId
+		firewall, err := firewallClient.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Firewall %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+		}
+		if props := firewall.AzureFirewallPropertiesFormat; props != nil && props.IPConfigurations != nil {
+			// only the first IP Configuration of a Firewall references the Subnet
+			for _, config := range *props.IPConfigurations {
+				if config.AzureFirewallIPConfigurationPropertiesFormat != nil {
+					if v := subnetId(config.AzureFirewallIPConfigurationPropertiesFormat.Subnet); v != "" {
+						return v, nil
+					}
+				}
+			}
+		}
+		return "", nil
+	}
+
+	if name, ok := segments["bastionhosts"]; ok {
+		hostClient := *client.Network.BastionHostsClient
+		hostClient.SubscriptionID = subscriptionId
+		host, err := hostClient.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Bastion Host %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+		}
+		if props := host.BastionHostPropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				if config.BastionHostIPConfigurationPropertiesFormat != nil {
+					if v := subnetId(config.BastionHostIPConfigurationPropertiesFormat.Subnet); v != "" {
+						return v, nil
+					}
+				}
+			}
+		}
+		return "", nil
+	}
+
+	if name, ok := segments["virtualnetworkgateways"]; ok {
+		gatewayClient := *client.Network.VnetGatewayClient
+		gatewayClient.SubscriptionID = subscriptionId
+		gateway, err := gatewayClient.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Virtual Network Gateway %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+		}
+		if props := gateway.VirtualNetworkGatewayPropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				if config.VirtualNetworkGatewayIPConfigurationPropertiesFormat != nil {
+					if v := subnetId(config.VirtualNetworkGatewayIPConfigurationPropertiesFormat.Subnet); v != "" {
+						return v, nil
+					}
+				}
+			}
+		}
+		return "", nil
+	}
+
+	// the frontend of a public Load Balancer isn't deployed into a Subnet, so the Virtual Network can't be determined
+	return "", nil
+}
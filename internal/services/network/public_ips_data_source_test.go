@@ -45,6 +45,8 @@ func TestAccDataSourcePublicIPs_assigned(t *testing.T) {
 				acceptance.TestCheckResourceAttr(attachedDataSourceName, "public_ips.#", "4"),
 				acceptance.TestCheckResourceAttr(attachedDataSourceName, "public_ips.0.name", fmt.Sprintf("acctestpip%s-0", data.RandomString)),
 				acceptance.TestCheckResourceAttr(attachedDataSourceName, "public_ips.3.name", fmt.Sprintf("acctestpip%s-3", data.RandomString)),
+				acceptance.TestCheckResourceAttr(attachedDataSourceName, "public_ips.0.ddos_protection_mode", "Unknown"),
+				acceptance.TestCheckResourceAttr(attachedDataSourceName, "public_ips.0.ddos_protection_plan_id", ""),
 				acceptance.TestCheckResourceAttr(unattachedDataSourceName, "public_ips.#", "4"),
 				acceptance.TestCheckResourceAttr(unattachedDataSourceName, "public_ips.0.name", fmt.Sprintf("acctestpip%s-4", data.RandomString)),
 			),
@@ -52,6 +54,44 @@ func TestAccDataSourcePublicIPs_assigned(t *testing.T) {
 	})
 }
 
+func testAccDataSourcePublicIPs_ddosProtectionVirtualNetworkInherited(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_public_ips", "test")
+	r := PublicIPsResource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.ddosProtection(data),
+		},
+		{
+			Config: r.ddosProtectionDataSource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("public_ips.#").HasValue("1"),
+				check.That(data.ResourceName).Key("public_ips.0.ddos_protection_mode").HasValue("VirtualNetworkInherited"),
+				check.That(data.ResourceName).Key("public_ips.0.ddos_protection_plan_id").Exists(),
+			),
+		},
+	})
+}
+
+func testAccDataSourcePublicIPs_ddosProtectionBastionVirtualNetworkInherited(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_public_ips", "test")
+	r := PublicIPsResource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.ddosProtectionBastion(data),
+		},
+		{
+			Config: r.ddosProtectionBastionDataSource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("public_ips.#").HasValue("1"),
+				check.That(data.ResourceName).Key("public_ips.0.ddos_protection_mode").HasValue("VirtualNetworkInherited"),
+				check.That(data.ResourceName).Key("public_ips.0.ddos_protection_plan_id").Exists(),
+			),
+		},
+	})
+}
+
 func TestAccDataSourcePublicIPs_allocationType(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azurerm_public_ips", "test")
 	r := PublicIPsResource{}
@@ -75,6 +115,145 @@ func TestAccDataSourcePublicIPs_allocationType(t *testing.T) {
 	})
 }
 
+func (PublicIPsResource) ddosProtection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ddos_protection_plan {
+    id     = azurerm_network_ddos_protection_plan.test.id
+    enable = true
+  }
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+    public_ip_address_id          = azurerm_public_ip.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r PublicIPsResource) ddosProtectionDataSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_public_ips" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  attachment_status   = "Attached"
+}
+`, r.ddosProtection(data))
+}
+
+func (PublicIPsResource) ddosProtectionBastion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["192.168.1.0/24"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ddos_protection_plan {
+    id     = azurerm_network_ddos_protection_plan.test.id
+    enable = true
+  }
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureBastionSubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["192.168.1.224/27"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_bastion_host" "test" {
+  name                = "acctestBastion%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = azurerm_subnet.test.id
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r PublicIPsResource) ddosProtectionBastionDataSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_public_ips" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  attachment_status   = "Attached"
+}
+`, r.ddosProtectionBastion(data))
+}
+
 func (PublicIPsResource) attached(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,99 @@
+package adminrules
+
+type AddressPrefixType string
+
+const (
+	AddressPrefixTypeIPPrefix   AddressPrefixType = "IPPrefix"
+	AddressPrefixTypeServiceTag AddressPrefixType = "ServiceTag"
+)
+
+func PossibleValuesForAddressPrefixType() []string {
+	return []string{
+		string(AddressPrefixTypeIPPrefix),
+		string(AddressPrefixTypeServiceTag),
+	}
+}
+
+type AdminRuleKind string
+
+const (
+	AdminRuleKindCustom  AdminRuleKind = "Custom"
+	AdminRuleKindDefault AdminRuleKind = "Default"
+)
+
+func PossibleValuesForAdminRuleKind() []string {
+	return []string{
+		string(AdminRuleKindCustom),
+		string(AdminRuleKindDefault),
+	}
+}
+
+type SecurityConfigurationRuleAccess string
+
+const (
+	SecurityConfigurationRuleAccessAllow       SecurityConfigurationRuleAccess = "Allow"
+	SecurityConfigurationRuleAccessAlwaysAllow SecurityConfigurationRuleAccess = "AlwaysAllow"
+	SecurityConfigurationRuleAccessDeny        SecurityConfigurationRuleAccess = "Deny"
+)
+
+func PossibleValuesForSecurityConfigurationRuleAccess() []string {
+	return []string{
+		string(SecurityConfigurationRuleAccessAllow),
+		string(SecurityConfigurationRuleAccessAlwaysAllow),
+		string(SecurityConfigurationRuleAccessDeny),
+	}
+}
+
+type SecurityConfigurationRuleDirection string
+
+const (
+	SecurityConfigurationRuleDirectionInbound  SecurityConfigurationRuleDirection = "Inbound"
+	SecurityConfigurationRuleDirectionOutbound SecurityConfigurationRuleDirection = "Outbound"
+)
+
+func PossibleValuesForSecurityConfigurationRuleDirection() []string {
+	return []string{
+		string(SecurityConfigurationRuleDirectionInbound),
+		string(SecurityConfigurationRuleDirectionOutbound),
+	}
+}
+
+type SecurityConfigurationRuleProtocol string
+
+const (
+	SecurityConfigurationRuleProtocolAh   SecurityConfigurationRuleProtocol = "Ah"
+	SecurityConfigurationRuleProtocolAny  SecurityConfigurationRuleProtocol = "Any"
+	SecurityConfigurationRuleProtocolEsp  SecurityConfigurationRuleProtocol = "Esp"
+	SecurityConfigurationRuleProtocolIcmp SecurityConfigurationRuleProtocol = "Icmp"
+	SecurityConfigurationRuleProtocolTcp  SecurityConfigurationRuleProtocol = "Tcp"
+	SecurityConfigurationRuleProtocolUdp  SecurityConfigurationRuleProtocol = "Udp"
+)
+
+func PossibleValuesForSecurityConfigurationRuleProtocol() []string {
+	return []string{
+		string(SecurityConfigurationRuleProtocolAh),
+		string(SecurityConfigurationRuleProtocolAny),
+		string(SecurityConfigurationRuleProtocolEsp),
+		string(SecurityConfigurationRuleProtocolIcmp),
+		string(SecurityConfigurationRuleProtocolTcp),
+		string(SecurityConfigurationRuleProtocolUdp),
+	}
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
@@ -0,0 +1,89 @@
+package connectivityconfigurations
+
+type ConnectivityTopology string
+
+const (
+	ConnectivityTopologyHubAndSpoke ConnectivityTopology = "HubAndSpoke"
+	ConnectivityTopologyMesh        ConnectivityTopology = "Mesh"
+)
+
+func PossibleValuesForConnectivityTopology() []string {
+	return []string{
+		string(ConnectivityTopologyHubAndSpoke),
+		string(ConnectivityTopologyMesh),
+	}
+}
+
+type DeleteExistingPeering string
+
+const (
+	DeleteExistingPeeringFalse DeleteExistingPeering = "False"
+	DeleteExistingPeeringTrue  DeleteExistingPeering = "True"
+)
+
+func PossibleValuesForDeleteExistingPeering() []string {
+	return []string{
+		string(DeleteExistingPeeringFalse),
+		string(DeleteExistingPeeringTrue),
+	}
+}
+
+type GroupConnectivity string
+
+const (
+	GroupConnectivityDirectlyConnected GroupConnectivity = "DirectlyConnected"
+	GroupConnectivityNone              GroupConnectivity = "None"
+)
+
+func PossibleValuesForGroupConnectivity() []string {
+	return []string{
+		string(GroupConnectivityDirectlyConnected),
+		string(GroupConnectivityNone),
+	}
+}
+
+type IsGlobal string
+
+const (
+	IsGlobalFalse IsGlobal = "False"
+	IsGlobalTrue  IsGlobal = "True"
+)
+
+func PossibleValuesForIsGlobal() []string {
+	return []string{
+		string(IsGlobalFalse),
+		string(IsGlobalTrue),
+	}
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+type UseHubGateway string
+
+const (
+	UseHubGatewayFalse UseHubGateway = "False"
+	UseHubGatewayTrue  UseHubGateway = "True"
+)
+
+func PossibleValuesForUseHubGateway() []string {
+	return []string{
+		string(UseHubGatewayFalse),
+		string(UseHubGatewayTrue),
+	}
+}
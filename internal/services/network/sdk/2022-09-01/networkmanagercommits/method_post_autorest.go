@@ -0,0 +1,76 @@
+package networkmanagercommits
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PostOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Post ...
+func (c NetworkManagerCommitsClient) Post(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) (result PostOperationResponse, err error) {
+	req, err := c.preparerForPost(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagercommits.NetworkManagerCommitsClient", "Post", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPost(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagercommits.NetworkManagerCommitsClient", "Post", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PostThenPoll performs Post then polls until it's completed
+func (c NetworkManagerCommitsClient) PostThenPoll(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) error {
+	result, err := c.Post(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Post: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Post: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPost prepares the Post request.
+func (c NetworkManagerCommitsClient) preparerForPost(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/commit", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPost sends the Post request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagerCommitsClient) senderForPost(ctx context.Context, req *http.Request) (future PostOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,15 @@
+package networkmanagerdeploymentstatus
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkManagerDeploymentStatusClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkManagerDeploymentStatusClientWithBaseURI(endpoint string) NetworkManagerDeploymentStatusClient {
+	return NetworkManagerDeploymentStatusClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package networkmanagers
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networkmanagers/%s", defaultApiVersion)
+}
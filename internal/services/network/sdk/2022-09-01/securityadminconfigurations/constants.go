@@ -0,0 +1,35 @@
+package securityadminconfigurations
+
+type NetworkIntentPolicyBasedService string
+
+const (
+	NetworkIntentPolicyBasedServiceAll            NetworkIntentPolicyBasedService = "All"
+	NetworkIntentPolicyBasedServiceAllowRulesOnly NetworkIntentPolicyBasedService = "AllowRulesOnly"
+	NetworkIntentPolicyBasedServiceNone           NetworkIntentPolicyBasedService = "None"
+)
+
+func PossibleValuesForNetworkIntentPolicyBasedService() []string {
+	return []string{
+		string(NetworkIntentPolicyBasedServiceAll),
+		string(NetworkIntentPolicyBasedServiceAllowRulesOnly),
+		string(NetworkIntentPolicyBasedServiceNone),
+	}
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
@@ -0,0 +1,9 @@
+package securityadminconfigurations
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/securityadminconfigurations/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package staticmembers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StaticMembersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStaticMembersClientWithBaseURI(endpoint string) StaticMembersClient {
+	return StaticMembersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package staticmembers
+
+type StaticMember struct {
+	Etag       *string                 `json:"etag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *StaticMemberProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
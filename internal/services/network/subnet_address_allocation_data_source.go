@@ -0,0 +1,276 @@
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceSubnetAddressAllocation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSubnetAddressAllocationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkID,
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"prefix_length": {
+							Type:     pluginsdk.TypeInt,
+							Required: true,
+							// Azure reserves 5 addresses in each Subnet, so a /29 is the smallest usable Subnet
+							ValidateFunc: validation.IntBetween(1, 29),
+						},
+					},
+				},
+			},
+
+			"address_prefixes": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSubnetAddressAllocationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	addressSpaces := make([]string, 0)
+	existing := make(map[string][]string)
+	if props := resp.VirtualNetworkPropertiesFormat; props != nil {
+		if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+			addressSpaces = *props.AddressSpace.AddressPrefixes
+		}
+
+		if props.Subnets != nil {
+			for _, subnet := range *props.Subnets {
+				if subnet.Name == nil || subnet.SubnetPropertiesFormat == nil {
+					continue
+				}
+				prefixes := make([]string, 0)
+				if v := subnet.SubnetPropertiesFormat.AddressPrefix; v != nil && *v != "" {
+					prefixes = append(prefixes, *v)
+				}
+				if v := subnet.SubnetPropertiesFormat.AddressPrefixes; v != nil {
+					prefixes = append(prefixes, *v...)
+				}
+				existing[*subnet.Name] = prefixes
+			}
+		}
+	}
+
+	requests := make([]subnetAddressAllocationRequest, 0)
+	for _, raw := range d.Get("subnet").([]interface{}) {
+		v := raw.(map[string]interface{})
+		requests = append(requests, subnetAddressAllocationRequest{
+			Name:         v["name"].(string),
+			PrefixLength: v["prefix_length"].(int),
+		})
+	}
+
+	allocated, err := allocateSubnetAddressPrefixes(addressSpaces, existing, requests)
+	if err != nil {
+		return fmt.Errorf("allocating Subnet address prefixes within %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("address_prefixes", allocated); err != nil {
+		return fmt.Errorf("setting `address_prefixes`: %+v", err)
+	}
+
+	return nil
+}
+
+type subnetAddressAllocationRequest struct {
+	Name         string
+	PrefixLength int
+}
+
+type ipv4Range struct {
+	start uint32
+	end   uint32
+}
+
+func (r ipv4Range) overlaps(other ipv4Range) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+// allocateSubnetAddressPrefixes returns an IPv4 address prefix for each of the requested Subnets which doesn't overlap any
+// of the existing Subnets. A Subnet which already exists with the requested prefix length retains its current address prefix,
+// meaning that the result remains the same once the allocated Subnets have been created. The remaining Subnets are allocated
+// largest first, each taking the lowest available range within the address spaces, so the same inputs give the same result.
+func allocateSubnetAddressPrefixes(addressSpaces []string, existing map[string][]string, requests []subnetAddressAllocationRequest) (map[string]string, error) {
+	spaces := make([]ipv4Range, 0)
+	for _, v := range addressSpaces {
+		if r, ok := parseIPv4Range(v); ok {
+			spaces = append(spaces, r)
+		}
+	}
+	sort.Slice(spaces, func(i, j int) bool {
+		if spaces[i].start == spaces[j].start {
+			return spaces[i].end < spaces[j].end
+		}
+		return spaces[i].start < spaces[j].start
+	})
+
+	reserved := make([]ipv4Range, 0)
+	for _, prefixes := range existing {
+		for _, v := range prefixes {
+			if r, ok := parseIPv4Range(v); ok {
+				reserved = append(reserved, r)
+			}
+		}
+	}
+
+	output := make(map[string]string)
+	pending := make([]subnetAddressAllocationRequest, 0)
+	for _, request := range requests {
+		if _, ok := output[request.Name]; ok {
+			return nil, fmt.Errorf("the Subnet %q was specified more than once", request.Name)
+		}
+		output[request.Name] = ""
+
+		if prefix, ok := existingSubnetPrefixWithLength(existing[request.Name], request.PrefixLength); ok {
+			output[request.Name] = prefix
+			continue
+		}
+		pending = append(pending, request)
+	}
+
+	// allocating the largest ranges first avoids fragmenting the address space
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].PrefixLength == pending[j].PrefixLength {
+			return pending[i].Name < pending[j].Name
+		}
+		return pending[i].PrefixLength < pending[j].PrefixLength
+	})
+
+	for _, request := range pending {
+		allocated, ok := allocateIPv4Range(spaces, reserved, request.PrefixLength)
+		if !ok {
+			return nil, fmt.Errorf("there's insufficient space within the address spaces %v to allocate a /%d for the Subnet %q", addressSpaces, request.PrefixLength, request.Name)
+		}
+
+		reserved = append(reserved, allocated)
+		output[request.Name] = fmt.Sprintf("%s/%d", formatIPv4(allocated.start), request.PrefixLength)
+	}
+
+	return output, nil
+}
+
+func allocateIPv4Range(spaces []ipv4Range, reserved []ipv4Range, prefixLength int) (ipv4Range, bool) {
+	size := uint64(1) << uint(32-prefixLength)
+
+	for _, space := range spaces {
+		// candidates must be aligned to their size to form a valid CIDR
+		start := (uint64(space.start) + size - 1) / size * size
+		for start+size-1 <= uint64(space.end) {
+			candidate := ipv4Range{
+				start: uint32(start),
+				end:   uint32(start + size - 1),
+			}
+
+			var overlapping *ipv4Range
+			for i := range reserved {
+				if reserved[i].overlaps(candidate) {
+					overlapping = &reserved[i]
+					break
+				}
+			}
+			if overlapping == nil {
+				return candidate, true
+			}
+
+			// skip to the next aligned range after the overlapping range
+			start = (uint64(overlapping.end) + size) / size * size
+		}
+	}
+
+	return ipv4Range{}, false
+}
+
+func existingSubnetPrefixWithLength(prefixes []string, prefixLength int) (string, bool) {
+	for _, v := range prefixes {
+		_, network, err := net.ParseCIDR(v)
+		if err != nil || network.IP.To4() == nil {
+			continue
+		}
+		if ones, _ := network.Mask.Size(); ones == prefixLength {
+			return network.String(), true
+		}
+	}
+	return "", false
+}
+
+// parseIPv4Range parses an IPv4 CIDR into the range of addresses it contains, returning false for IPv6 CIDRs
+func parseIPv4Range(input string) (ipv4Range, bool) {
+	_, network, err := net.ParseCIDR(input)
+	if err != nil {
+		return ipv4Range{}, false
+	}
+	ip := network.IP.To4()
+	if ip == nil {
+		return ipv4Range{}, false
+	}
+
+	ones, _ := network.Mask.Size()
+	start := binary.BigEndian.Uint32(ip)
+	return ipv4Range{
+		start: start,
+		end:   start + uint32((uint64(1)<<uint(32-ones))-1),
+	}, true
+}
+
+func formatIPv4(input uint32) string {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, input)
+	return ip.String()
+}
@@ -0,0 +1,114 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SubnetAddressAllocationDataSource struct{}
+
+func TestAccDataSourceSubnetAddressAllocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_address_allocation", "test")
+	r := SubnetAddressAllocationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.%").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.frontend").HasValue("10.0.1.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.backend").HasValue("10.0.0.128/26"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceSubnetAddressAllocation_existingSubnets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_address_allocation", "test")
+	r := SubnetAddressAllocationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.existingSubnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.%").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.existing").HasValue("10.0.0.0/25"),
+				check.That(data.ResourceName).Key("address_prefixes.frontend").HasValue("10.0.1.0/24"),
+			),
+		},
+	})
+}
+
+func (SubnetAddressAllocationDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "existing" {
+  name                 = "existing"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/25"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r SubnetAddressAllocationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subnet_address_allocation" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  subnet {
+    name          = "frontend"
+    prefix_length = 24
+  }
+
+  subnet {
+    name          = "backend"
+    prefix_length = 26
+  }
+
+  depends_on = [azurerm_subnet.existing]
+}
+`, r.template(data))
+}
+
+func (r SubnetAddressAllocationDataSource) existingSubnets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subnet_address_allocation" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  subnet {
+    name          = "existing"
+    prefix_length = 25
+  }
+
+  subnet {
+    name          = "frontend"
+    prefix_length = 24
+  }
+
+  depends_on = [azurerm_subnet.existing]
+}
+`, r.template(data))
+}
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllocateSubnetAddressPrefixes(t *testing.T) {
+	testData := []struct {
+		Name          string
+		AddressSpaces []string
+		Existing      map[string][]string
+		Requests      []subnetAddressAllocationRequest
+		Expected      map[string]string
+		Error         bool
+	}{
+		{
+			Name:          "Empty Virtual Network",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Existing:      map[string][]string{},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "small", PrefixLength: 26},
+				{Name: "large", PrefixLength: 24},
+			},
+			Expected: map[string]string{
+				"large": "10.0.0.0/24",
+				"small": "10.0.1.0/26",
+			},
+		},
+		{
+			Name:          "Skips Existing Subnets",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Existing: map[string][]string{
+				"first":  {"10.0.0.0/25"},
+				"second": {"10.0.1.0/24"},
+			},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "new", PrefixLength: 24},
+				{Name: "tiny", PrefixLength: 28},
+			},
+			Expected: map[string]string{
+				"new":  "10.0.2.0/24",
+				"tiny": "10.0.0.128/28",
+			},
+		},
+		{
+			Name:          "Retains Existing Subnets with the same Prefix Length",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Existing: map[string][]string{
+				"large": {"10.0.0.0/24"},
+				"small": {"10.0.1.0/26"},
+			},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "small", PrefixLength: 26},
+				{Name: "large", PrefixLength: 24},
+			},
+			Expected: map[string]string{
+				"large": "10.0.0.0/24",
+				"small": "10.0.1.0/26",
+			},
+		},
+		{
+			Name:          "Reallocates Existing Subnets with a different Prefix Length",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Existing: map[string][]string{
+				"resized": {"10.0.0.0/24"},
+			},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "resized", PrefixLength: 23},
+			},
+			Expected: map[string]string{
+				"resized": "10.0.2.0/23",
+			},
+		},
+		{
+			Name:          "Multiple Address Spaces",
+			AddressSpaces: []string{"192.168.0.0/24", "10.0.0.0/24", "fd00::/48"},
+			Existing: map[string][]string{
+				"existing": {"10.0.0.0/24", "fd00::/64"},
+			},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "new", PrefixLength: 25},
+			},
+			Expected: map[string]string{
+				"new": "192.168.0.0/25",
+			},
+		},
+		{
+			Name:          "Insufficient Space",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			Existing:      map[string][]string{},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "first", PrefixLength: 25},
+				{Name: "second", PrefixLength: 25},
+				{Name: "third", PrefixLength: 25},
+			},
+			Error: true,
+		},
+		{
+			Name:          "Duplicate Names",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Existing:      map[string][]string{},
+			Requests: []subnetAddressAllocationRequest{
+				{Name: "subnet", PrefixLength: 24},
+				{Name: "subnet", PrefixLength: 25},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := allocateSubnetAddressPrefixes(v.AddressSpaces, v.Existing, v.Requests)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error for %q: %+v", v.Name, err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v for %q", v.Expected, actual, v.Name)
+		}
+	}
+}
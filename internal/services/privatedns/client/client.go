@@ -1,6 +1,12 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
+	resolvervirtualnetworklinks "github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/virtualnetworklinks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/virtualnetworklinks"
@@ -11,6 +17,13 @@ type Client struct {
 	RecordSetsClient          *recordsets.RecordSetsClient
 	PrivateZonesClient        *privatezones.PrivateZonesClient
 	VirtualNetworkLinksClient *virtualnetworklinks.VirtualNetworkLinksClient
+
+	DnsForwardingRulesetsClient       *dnsforwardingrulesets.DnsForwardingRulesetsClient
+	DnsResolversClient                *dnsresolvers.DnsResolversClient
+	ForwardingRulesClient             *forwardingrules.ForwardingRulesClient
+	InboundEndpointsClient            *inboundendpoints.InboundEndpointsClient
+	OutboundEndpointsClient           *outboundendpoints.OutboundEndpointsClient
+	ResolverVirtualNetworkLinksClient *resolvervirtualnetworklinks.VirtualNetworkLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -23,9 +36,34 @@ func NewClient(o *common.ClientOptions) *Client {
 	virtualNetworkLinksClient := virtualnetworklinks.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&virtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
 
+	dnsForwardingRulesetsClient := dnsforwardingrulesets.NewDnsForwardingRulesetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsForwardingRulesetsClient.Client, o.ResourceManagerAuthorizer)
+
+	dnsResolversClient := dnsresolvers.NewDnsResolversClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsResolversClient.Client, o.ResourceManagerAuthorizer)
+
+	forwardingRulesClient := forwardingrules.NewForwardingRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&forwardingRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	inboundEndpointsClient := inboundendpoints.NewInboundEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&inboundEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	outboundEndpointsClient := outboundendpoints.NewOutboundEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&outboundEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	resolverVirtualNetworkLinksClient := resolvervirtualnetworklinks.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resolverVirtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		RecordSetsClient:          &recordSetsClient,
 		PrivateZonesClient:        &privateZonesClient,
 		VirtualNetworkLinksClient: &virtualNetworkLinksClient,
+
+		DnsForwardingRulesetsClient:       &dnsForwardingRulesetsClient,
+		DnsResolversClient:                &dnsResolversClient,
+		ForwardingRulesClient:             &forwardingRulesClient,
+		InboundEndpointsClient:            &inboundEndpointsClient,
+		OutboundEndpointsClient:           &outboundEndpointsClient,
+		ResolverVirtualNetworkLinksClient: &resolverVirtualNetworkLinksClient,
 	}
 }
@@ -0,0 +1,301 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDNSResolverInboundEndpointModel struct {
+	Name                  string                  `tfschema:"name"`
+	PrivateDNSResolverId  string                  `tfschema:"private_dns_resolver_id"`
+	Location              string                  `tfschema:"location"`
+	IPConfigurationsModel []IPConfigurationsModel `tfschema:"ip_configurations"`
+	Tags                  map[string]string       `tfschema:"tags"`
+}
+
+type IPConfigurationsModel struct {
+	SubnetId                  string `tfschema:"subnet_id"`
+	PrivateIPAddress          string `tfschema:"private_ip_address"`
+	PrivateIPAllocationMethod string `tfschema:"private_ip_allocation_method"`
+}
+
+type PrivateDNSResolverInboundEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverInboundEndpointResource{}
+
+func (r PrivateDNSResolverInboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_inbound_endpoint"
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDNSResolverInboundEndpointModel{}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return inboundendpoints.ValidateInboundEndpointID
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDNSResolverName,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsresolvers.ValidateDnsResolverID,
+		},
+
+		"location": commonschema.Location(),
+
+		"ip_configurations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"private_ip_allocation_method": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(inboundendpoints.IPAllocationMethodDynamic),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(inboundendpoints.IPAllocationMethodDynamic),
+							string(inboundendpoints.IPAllocationMethodStatic),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			var model PrivateDNSResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			dnsResolverId, err := dnsresolvers.ParseDnsResolverID(model.PrivateDNSResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := inboundendpoints.NewInboundEndpointID(dnsResolverId.SubscriptionId, dnsResolverId.ResourceGroupName, dnsResolverId.DnsResolverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ipConfigurations, err := expandPrivateDNSResolverIPConfigurations(model.IPConfigurationsModel)
+			if err != nil {
+				return err
+			}
+
+			payload := inboundendpoints.InboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: inboundendpoints.InboundEndpointProperties{
+					IPConfigurations: ipConfigurations,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload, inboundendpoints.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDNSResolverInboundEndpointModel{
+				Name:                 id.InboundEndpointName,
+				PrivateDNSResolverId: dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.IPConfigurationsModel = flattenPrivateDNSResolverIPConfigurations(model.Properties.IPConfigurations)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDNSResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload, inboundendpoints.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverInboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, inboundendpoints.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDNSResolverIPConfigurations(input []IPConfigurationsModel) ([]inboundendpoints.IPConfiguration, error) {
+	output := make([]inboundendpoints.IPConfiguration, 0)
+	for _, v := range input {
+		allocationMethod := inboundendpoints.IPAllocationMethod(v.PrivateIPAllocationMethod)
+		ipConfiguration := inboundendpoints.IPConfiguration{
+			PrivateIPAllocationMethod: &allocationMethod,
+			Subnet: inboundendpoints.SubResource{
+				Id: v.SubnetId,
+			},
+		}
+
+		if allocationMethod == inboundendpoints.IPAllocationMethodStatic {
+			if v.PrivateIPAddress == "" {
+				return nil, fmt.Errorf("`private_ip_address` must be specified when `private_ip_allocation_method` is set to %q", string(inboundendpoints.IPAllocationMethodStatic))
+			}
+			ipConfiguration.PrivateIPAddress = utils.String(v.PrivateIPAddress)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return output, nil
+}
+
+func flattenPrivateDNSResolverIPConfigurations(input []inboundendpoints.IPConfiguration) []IPConfigurationsModel {
+	output := make([]IPConfigurationsModel, 0)
+	for _, v := range input {
+		item := IPConfigurationsModel{
+			SubnetId: v.Subnet.Id,
+		}
+
+		if v.PrivateIPAddress != nil {
+			item.PrivateIPAddress = *v.PrivateIPAddress
+		}
+
+		if v.PrivateIPAllocationMethod != nil {
+			item.PrivateIPAllocationMethod = string(*v.PrivateIPAllocationMethod)
+		}
+
+		output = append(output, item)
+	}
+
+	return output
+}
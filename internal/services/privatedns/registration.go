@@ -1,9 +1,15 @@
 package privatedns
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+var (
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.UntypedServiceRegistration               = Registration{}
+)
+
 type Registration struct{}
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -22,6 +28,23 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDNSResolverResource{},
+		PrivateDNSResolverDnsForwardingRulesetResource{},
+		PrivateDNSResolverForwardingRuleResource{},
+		PrivateDNSResolverInboundEndpointResource{},
+		PrivateDNSResolverOutboundEndpointResource{},
+		PrivateDNSResolverVirtualNetworkLinkResource{},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PrivateDNSResolverName validates the name of a Private DNS Resolver and its child resources
+func PrivateDNSResolverName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if len(value) < 1 || len(value) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9_])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter or number, end with a letter, number or underscore, and may only contain letters, numbers, underscores and dashes", k))
+		return warnings, errors
+	}
+
+	return warnings, errors
+}
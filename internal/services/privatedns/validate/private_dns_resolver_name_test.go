@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrivateDNSResolverName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "-resolver",
+			Errors: 1,
+		},
+		{
+			Value:  "resolver-",
+			Errors: 1,
+		},
+		{
+			Value:  "resolver.1",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a", 81),
+			Errors: 1,
+		},
+		{
+			Value:  "a",
+			Errors: 0,
+		},
+		{
+			Value:  "resolver_",
+			Errors: 0,
+		},
+		{
+			Value:  "example-resolver_1",
+			Errors: 0,
+		},
+		{
+			Value:  strings.Repeat("a", 80),
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PrivateDNSResolverName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected PrivateDNSResolverName to return %d error(s) not %d for %q", tc.Errors, len(errors), tc.Value)
+		}
+	}
+}
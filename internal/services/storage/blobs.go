@@ -3,6 +3,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -69,8 +70,8 @@ func (sbu BlobUpload) Create(ctx context.Context) error {
 	}
 
 	if blobType == "page" {
-		if sbu.ContentMD5 != "" {
-			return fmt.Errorf("`content_md5` cannot be specified for a Page blob")
+		if sbu.ContentMD5 != "" && sbu.Source == "" {
+			return fmt.Errorf("`content_md5` can only be specified for a Page blob when uploading from a `source` file")
 		}
 		if sbu.SourceUri != "" {
 			return sbu.copy(ctx)
@@ -217,6 +218,18 @@ func (sbu BlobUpload) uploadPageBlob(ctx context.Context) error {
 		return fmt.Errorf("creating storage blob on Azure: %s", err)
 	}
 
+	// the Content-MD5 isn't calculated by the service for Page blobs, so we set it once all of the pages are uploaded
+	if sbu.ContentMD5 != "" {
+		propertiesInput := blobs.SetPropertiesInput{
+			CacheControl: utils.String(sbu.CacheControl),
+			ContentMD5:   utils.String(sbu.ContentMD5),
+			ContentType:  utils.String(sbu.ContentType),
+		}
+		if _, err := sbu.Client.SetProperties(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, propertiesInput); err != nil {
+			return fmt.Errorf("setting Content MD5: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -364,6 +377,33 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+// computeFileMD5 returns the hex encoded MD5 of the file at the specified path. The file is read in fixed-size
+// chunks so that large Page/Block blob sources aren't loaded into memory in their entirety.
+func computeFileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	buf := make([]byte, maxPageSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("reading %q: %+v", path, err)
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -0,0 +1,32 @@
+package shim
+
+import (
+	"context"
+	"time"
+)
+
+type StorageBlobWrapper interface {
+	GetIndexTags(ctx context.Context, accountName, containerName, blobName string) (map[string]string, error)
+	UpdateIndexTags(ctx context.Context, accountName, containerName, blobName string, tags map[string]string) error
+	GetImmutability(ctx context.Context, accountName, containerName, blobName string) (*StorageBlobImmutabilityProperties, error)
+	UpdateImmutabilityPolicy(ctx context.Context, accountName, containerName, blobName string, policy StorageBlobImmutabilityPolicy) error
+	DeleteImmutabilityPolicy(ctx context.Context, accountName, containerName, blobName string) error
+	UpdateLegalHold(ctx context.Context, accountName, containerName, blobName string, legalHold bool) error
+}
+
+type StorageBlobImmutabilityProperties struct {
+	ImmutabilityPolicy *StorageBlobImmutabilityPolicy
+	HasLegalHold       bool
+}
+
+type StorageBlobImmutabilityPolicy struct {
+	ExpiryTime time.Time
+	Mode       StorageBlobImmutabilityPolicyMode
+}
+
+type StorageBlobImmutabilityPolicyMode string
+
+const (
+	StorageBlobImmutabilityPolicyModeLocked   StorageBlobImmutabilityPolicyMode = "Locked"
+	StorageBlobImmutabilityPolicyModeUnlocked StorageBlobImmutabilityPolicyMode = "Unlocked"
+)
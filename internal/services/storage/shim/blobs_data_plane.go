@@ -0,0 +1,213 @@
+package shim
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+const (
+	// Blob Index Tags are available from API Version 2019-12-12, however Version-Level Immutability
+	// Policies and Legal Holds require a newer API Version than Giovanni currently supports
+	blobIndexTagsAPIVersion    = "2019-12-12"
+	blobImmutabilityAPIVersion = "2020-10-02"
+)
+
+type DataPlaneStorageBlobWrapper struct {
+	client *blobs.Client
+}
+
+func NewDataPlaneStorageBlobWrapper(client *blobs.Client) StorageBlobWrapper {
+	return DataPlaneStorageBlobWrapper{
+		client: client,
+	}
+}
+
+type blobIndexTags struct {
+	XMLName xml.Name       `xml:"Tags"`
+	TagSet  []blobIndexTag `xml:"TagSet>Tag"`
+}
+
+type blobIndexTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func (w DataPlaneStorageBlobWrapper) GetIndexTags(ctx context.Context, accountName, containerName, blobName string) (map[string]string, error) {
+	req, err := w.preparer(ctx, accountName, containerName, blobName, "tags", blobIndexTagsAPIVersion, autorest.AsGet())
+	if err != nil {
+		return nil, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := w.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %+v", err)
+	}
+
+	var result blobIndexTags
+	err = autorest.Respond(
+		resp,
+		w.client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Index Tags: %+v", err)
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range result.TagSet {
+		tags[tag.Key] = tag.Value
+	}
+	return tags, nil
+}
+
+func (w DataPlaneStorageBlobWrapper) UpdateIndexTags(ctx context.Context, accountName, containerName, blobName string, tags map[string]string) error {
+	// the ordering of the Tags doesn't matter to the API, but sorting them keeps the request deterministic
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	input := blobIndexTags{
+		TagSet: make([]blobIndexTag, 0),
+	}
+	for _, k := range keys {
+		input.TagSet = append(input.TagSet, blobIndexTag{
+			Key:   k,
+			Value: tags[k],
+		})
+	}
+
+	req, err := w.preparer(ctx, accountName, containerName, blobName, "tags", blobIndexTagsAPIVersion, autorest.AsPut(), autorest.WithXML(input))
+	if err != nil {
+		return fmt.Errorf("preparing request: %+v", err)
+	}
+
+	return w.sendAndRespond(req, http.StatusNoContent)
+}
+
+func (w DataPlaneStorageBlobWrapper) GetImmutability(ctx context.Context, accountName, containerName, blobName string) (*StorageBlobImmutabilityProperties, error) {
+	// the Blob Properties returned by Giovanni use an API Version which doesn't include the Immutability headers
+	req, err := w.preparer(ctx, accountName, containerName, blobName, "", blobImmutabilityAPIVersion, autorest.AsHead())
+	if err != nil {
+		return nil, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := w.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		w.client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Immutability properties: %+v", err)
+	}
+
+	output := StorageBlobImmutabilityProperties{
+		HasLegalHold: strings.EqualFold(resp.Header.Get("x-ms-legal-hold"), "true"),
+	}
+
+	if v := resp.Header.Get("x-ms-immutability-policy-until-date"); v != "" {
+		expiryTime, err := time.Parse(http.TimeFormat, v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `x-ms-immutability-policy-until-date` %q: %+v", v, err)
+		}
+
+		mode := StorageBlobImmutabilityPolicyModeUnlocked
+		if strings.EqualFold(resp.Header.Get("x-ms-immutability-policy-mode"), string(StorageBlobImmutabilityPolicyModeLocked)) {
+			mode = StorageBlobImmutabilityPolicyModeLocked
+		}
+
+		output.ImmutabilityPolicy = &StorageBlobImmutabilityPolicy{
+			ExpiryTime: expiryTime,
+			Mode:       mode,
+		}
+	}
+
+	return &output, nil
+}
+
+func (w DataPlaneStorageBlobWrapper) UpdateImmutabilityPolicy(ctx context.Context, accountName, containerName, blobName string, policy StorageBlobImmutabilityPolicy) error {
+	req, err := w.preparer(ctx, accountName, containerName, blobName, "immutabilityPolicies", blobImmutabilityAPIVersion,
+		autorest.AsPut(),
+		autorest.WithHeader("x-ms-immutability-policy-until-date", policy.ExpiryTime.UTC().Format(http.TimeFormat)),
+		autorest.WithHeader("x-ms-immutability-policy-mode", string(policy.Mode)))
+	if err != nil {
+		return fmt.Errorf("preparing request: %+v", err)
+	}
+
+	return w.sendAndRespond(req, http.StatusOK)
+}
+
+func (w DataPlaneStorageBlobWrapper) DeleteImmutabilityPolicy(ctx context.Context, accountName, containerName, blobName string) error {
+	req, err := w.preparer(ctx, accountName, containerName, blobName, "immutabilityPolicies", blobImmutabilityAPIVersion, autorest.AsDelete())
+	if err != nil {
+		return fmt.Errorf("preparing request: %+v", err)
+	}
+
+	return w.sendAndRespond(req, http.StatusOK)
+}
+
+func (w DataPlaneStorageBlobWrapper) UpdateLegalHold(ctx context.Context, accountName, containerName, blobName string, legalHold bool) error {
+	req, err := w.preparer(ctx, accountName, containerName, blobName, "legalhold", blobImmutabilityAPIVersion,
+		autorest.AsPut(),
+		autorest.WithHeader("x-ms-legal-hold", fmt.Sprintf("%t", legalHold)))
+	if err != nil {
+		return fmt.Errorf("preparing request: %+v", err)
+	}
+
+	return w.sendAndRespond(req, http.StatusOK)
+}
+
+func (w DataPlaneStorageBlobWrapper) preparer(ctx context.Context, accountName, containerName, blobName, comp, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"containerName": autorest.Encode("path", containerName),
+		"blobName":      autorest.Encode("path", blobName),
+	}
+
+	queryParameters := map[string]interface{}{}
+	if comp != "" {
+		queryParameters["comp"] = autorest.Encode("query", comp)
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.blob.%s", accountName, w.client.BaseURI)),
+		autorest.WithPathParameters("/{containerName}/{blobName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-version", apiVersion),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (w DataPlaneStorageBlobWrapper) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(w.client, req, azure.DoRetryWithRegistration(w.client.Client))
+}
+
+func (w DataPlaneStorageBlobWrapper) sendAndRespond(req *http.Request, expectedStatusCode int) error {
+	resp, err := w.send(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %+v", err)
+	}
+
+	return autorest.Respond(
+		resp,
+		w.client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(expectedStatusCode),
+		autorest.ByClosing())
+}
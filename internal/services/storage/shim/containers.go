@@ -13,6 +13,11 @@ type StorageContainerWrapper interface {
 	Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error)
 	UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metadata map[string]string) error
+	GetImmutabilityPolicy(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerImmutabilityPolicy, error)
+	UpdateImmutabilityPolicy(ctx context.Context, resourceGroup, accountName, containerName string, policy StorageContainerImmutabilityPolicy) error
+	DeleteImmutabilityPolicy(ctx context.Context, resourceGroup, accountName, containerName string) error
+	GetLegalHold(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerLegalHold, error)
+	UpdateLegalHold(ctx context.Context, resourceGroup, accountName, containerName string, legalHold StorageContainerLegalHold) error
 }
 
 type StorageContainerProperties struct {
@@ -21,3 +26,15 @@ type StorageContainerProperties struct {
 	HasImmutabilityPolicy bool
 	HasLegalHold          bool
 }
+
+type StorageContainerImmutabilityPolicy struct {
+	ImmutabilityPeriodInDays        int
+	Locked                          bool
+	ProtectedAppendWritesEnabled    bool
+	ProtectedAppendWritesAllEnabled bool
+}
+
+type StorageContainerLegalHold struct {
+	Tags                            []string
+	ProtectedAppendWritesAllEnabled bool
+}
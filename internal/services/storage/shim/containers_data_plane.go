@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
@@ -13,11 +14,15 @@ import (
 
 type DataPlaneStorageContainerWrapper struct {
 	client *containers.Client
+
+	// Immutability Policies and Legal Holds aren't exposed in the Data Plane API, so are managed using the Resource Manager API
+	resourceManagerClient *storage.BlobContainersClient
 }
 
-func NewDataPlaneStorageContainerWrapper(client *containers.Client) StorageContainerWrapper {
+func NewDataPlaneStorageContainerWrapper(client *containers.Client, resourceManagerClient *storage.BlobContainersClient) StorageContainerWrapper {
 	return DataPlaneStorageContainerWrapper{
-		client: client,
+		client:                client,
+		resourceManagerClient: resourceManagerClient,
 	}
 }
 
@@ -98,6 +103,187 @@ func (w DataPlaneStorageContainerWrapper) UpdateMetaData(ctx context.Context, _,
 	return err
 }
 
+func (w DataPlaneStorageContainerWrapper) GetImmutabilityPolicy(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerImmutabilityPolicy, error) {
+	existing, err := w.resourceManagerClient.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	props := existing.ContainerProperties
+	if props == nil || props.HasImmutabilityPolicy == nil || !*props.HasImmutabilityPolicy || props.ImmutabilityPolicy == nil || props.ImmutabilityPolicy.ImmutabilityPolicyProperty == nil {
+		return nil, nil
+	}
+
+	policy := props.ImmutabilityPolicy.ImmutabilityPolicyProperty
+	output := StorageContainerImmutabilityPolicy{
+		Locked: policy.State == storage.ImmutabilityPolicyStateLocked,
+	}
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		output.ImmutabilityPeriodInDays = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+	if policy.AllowProtectedAppendWrites != nil {
+		output.ProtectedAppendWritesEnabled = *policy.AllowProtectedAppendWrites
+	}
+	if policy.AllowProtectedAppendWritesAll != nil {
+		output.ProtectedAppendWritesAllEnabled = *policy.AllowProtectedAppendWritesAll
+	}
+	return &output, nil
+}
+
+func (w DataPlaneStorageContainerWrapper) UpdateImmutabilityPolicy(ctx context.Context, resourceGroup, accountName, containerName string, policy StorageContainerImmutabilityPolicy) error {
+	etag, locked, err := w.immutabilityPolicyState(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return err
+	}
+
+	var result storage.ImmutabilityPolicy
+	if locked {
+		// the only change which can be made to a Locked policy is to extend the immutability period
+		payload := &storage.ImmutabilityPolicy{
+			ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+				ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(policy.ImmutabilityPeriodInDays)),
+			},
+		}
+		result, err = w.resourceManagerClient.ExtendImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag, payload)
+		if err != nil {
+			return fmt.Errorf("extending Immutability Policy: %+v", err)
+		}
+	} else {
+		payload := &storage.ImmutabilityPolicy{
+			ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+				ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(policy.ImmutabilityPeriodInDays)),
+				AllowProtectedAppendWrites:            utils.Bool(policy.ProtectedAppendWritesEnabled),
+				AllowProtectedAppendWritesAll:         utils.Bool(policy.ProtectedAppendWritesAllEnabled),
+			},
+		}
+		result, err = w.resourceManagerClient.CreateOrUpdateImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, payload, etag)
+		if err != nil {
+			return fmt.Errorf("creating/updating Immutability Policy: %+v", err)
+		}
+	}
+
+	if policy.Locked && !locked {
+		if result.Etag == nil {
+			return fmt.Errorf("locking Immutability Policy: `etag` was nil")
+		}
+		if _, err := w.resourceManagerClient.LockImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, *result.Etag); err != nil {
+			return fmt.Errorf("locking Immutability Policy: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func (w DataPlaneStorageContainerWrapper) DeleteImmutabilityPolicy(ctx context.Context, resourceGroup, accountName, containerName string) error {
+	etag, locked, err := w.immutabilityPolicyState(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return err
+	}
+	if etag == "" {
+		return nil
+	}
+	if locked {
+		return fmt.Errorf("a Locked Immutability Policy cannot be deleted")
+	}
+
+	if _, err := w.resourceManagerClient.DeleteImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag); err != nil {
+		return fmt.Errorf("deleting Immutability Policy: %+v", err)
+	}
+	return nil
+}
+
+// immutabilityPolicyState returns the etag of the existing Immutability Policy (which is empty when no policy exists)
+// and whether the policy has been Locked
+func (w DataPlaneStorageContainerWrapper) immutabilityPolicyState(ctx context.Context, resourceGroup, accountName, containerName string) (string, bool, error) {
+	existing, err := w.resourceManagerClient.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return "", false, fmt.Errorf("retrieving Container: %+v", err)
+	}
+
+	props := existing.ContainerProperties
+	if props == nil || props.HasImmutabilityPolicy == nil || !*props.HasImmutabilityPolicy || props.ImmutabilityPolicy == nil || props.ImmutabilityPolicy.Etag == nil {
+		return "", false, nil
+	}
+
+	locked := false
+	if policy := props.ImmutabilityPolicy.ImmutabilityPolicyProperty; policy != nil {
+		locked = policy.State == storage.ImmutabilityPolicyStateLocked
+	}
+	return *props.ImmutabilityPolicy.Etag, locked, nil
+}
+
+func (w DataPlaneStorageContainerWrapper) GetLegalHold(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerLegalHold, error) {
+	existing, err := w.resourceManagerClient.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	props := existing.ContainerProperties
+	if props == nil || props.LegalHold == nil || props.LegalHold.Tags == nil || len(*props.LegalHold.Tags) == 0 {
+		return nil, nil
+	}
+
+	output := StorageContainerLegalHold{
+		Tags: make([]string, 0),
+	}
+	for _, tag := range *props.LegalHold.Tags {
+		if tag.Tag != nil {
+			output.Tags = append(output.Tags, *tag.Tag)
+		}
+	}
+	if history := props.LegalHold.ProtectedAppendWritesHistory; history != nil && history.AllowProtectedAppendWritesAll != nil {
+		output.ProtectedAppendWritesAllEnabled = *history.AllowProtectedAppendWritesAll
+	}
+	return &output, nil
+}
+
+func (w DataPlaneStorageContainerWrapper) UpdateLegalHold(ctx context.Context, resourceGroup, accountName, containerName string, legalHold StorageContainerLegalHold) error {
+	existing, err := w.GetLegalHold(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return fmt.Errorf("retrieving Legal Hold: %+v", err)
+	}
+
+	if len(legalHold.Tags) > 0 {
+		// setting a tag which is already present is a no-op, so we can send the full set
+		// which also allows `allowProtectedAppendWritesAll` to be updated
+		input := storage.LegalHold{
+			Tags:                          &legalHold.Tags,
+			AllowProtectedAppendWritesAll: utils.Bool(legalHold.ProtectedAppendWritesAllEnabled),
+		}
+		if _, err := w.resourceManagerClient.SetLegalHold(ctx, resourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("setting Legal Hold: %+v", err)
+		}
+	}
+
+	if existing == nil {
+		return nil
+	}
+
+	tagsToRemove := make([]string, 0)
+	for _, existingTag := range existing.Tags {
+		found := false
+		for _, tag := range legalHold.Tags {
+			if strings.EqualFold(existingTag, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tagsToRemove = append(tagsToRemove, existingTag)
+		}
+	}
+	if len(tagsToRemove) > 0 {
+		input := storage.LegalHold{
+			Tags: &tagsToRemove,
+		}
+		if _, err := w.resourceManagerClient.ClearLegalHold(ctx, resourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("clearing Legal Hold: %+v", err)
+		}
+	}
+
+	return nil
+}
+
 func (w DataPlaneStorageContainerWrapper) createRefreshFunc(ctx context.Context, accountName string, containerName string, input containers.CreateInput) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := w.client.Create(ctx, accountName, containerName, input)
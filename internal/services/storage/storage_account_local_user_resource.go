@@ -0,0 +1,468 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountLocalUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountLocalUserCreate,
+		Read:   resourceStorageAccountLocalUserRead,
+		Update: resourceStorageAccountLocalUserUpdate,
+		Delete: resourceStorageAccountLocalUserDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountLocalUserID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountLocalUserName,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"home_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssh_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_password_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_authorized_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"permission_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blob",
+								"file",
+							}, false),
+						},
+
+						"resource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"read": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"delete": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"list": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"create": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"password": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountLocalUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageAccountLocalUserID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_storage_account_local_user", id.ID())
+	}
+
+	sshKeyEnabled := d.Get("ssh_key_enabled").(bool)
+	sshPasswordEnabled := d.Get("ssh_password_enabled").(bool)
+	props := storage.LocalUser{
+		LocalUserProperties: &storage.LocalUserProperties{
+			PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+			SSHAuthorizedKeys: expandStorageAccountLocalUserSSHAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{})),
+			HasSSHKey:         utils.Bool(sshKeyEnabled),
+			HasSSHPassword:    utils.Bool(sshPasswordEnabled),
+		},
+	}
+	if v := d.Get("home_directory").(string); v != "" {
+		props.LocalUserProperties.HomeDirectory = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName, props); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the password is only returned when it's (re)generated, so we have to generate it here and persist it into the state
+	if sshPasswordEnabled {
+		resp, err := client.RegeneratePassword(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+		if err != nil {
+			return fmt.Errorf("generating password for %s: %+v", id, err)
+		}
+		if resp.SSHPassword == nil {
+			return fmt.Errorf("generating password for %s: `sshPassword` was nil", id)
+		}
+		d.Set("password", resp.SSHPassword)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sshPasswordEnabled := d.Get("ssh_password_enabled").(bool)
+	props := storage.LocalUser{
+		LocalUserProperties: &storage.LocalUserProperties{
+			PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+			SSHAuthorizedKeys: expandStorageAccountLocalUserSSHAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{})),
+			HasSSHKey:         utils.Bool(d.Get("ssh_key_enabled").(bool)),
+			HasSSHPassword:    utils.Bool(sshPasswordEnabled),
+			// an empty string is used to clear the Home Directory
+			HomeDirectory: utils.String(d.Get("home_directory").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName, props); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.HasChange("ssh_password_enabled") {
+		password := ""
+		if sshPasswordEnabled {
+			resp, err := client.RegeneratePassword(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+			if err != nil {
+				return fmt.Errorf("generating password for %s: %+v", *id, err)
+			}
+			if resp.SSHPassword == nil {
+				return fmt.Errorf("generating password for %s: `sshPassword` was nil", *id)
+			}
+			password = *resp.SSHPassword
+		}
+		d.Set("password", password)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountId := parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the SSH Authorized Keys aren't returned from the Get API, so we need to retrieve them separately
+	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName)
+	if err != nil {
+		return fmt.Errorf("retrieving keys for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.LocalUserName)
+	d.Set("storage_account_id", accountId.ID())
+
+	if props := resp.LocalUserProperties; props != nil {
+		homeDirectory := ""
+		if props.HomeDirectory != nil {
+			homeDirectory = *props.HomeDirectory
+		}
+		d.Set("home_directory", homeDirectory)
+		d.Set("ssh_key_enabled", props.HasSSHKey != nil && *props.HasSSHKey)
+		d.Set("ssh_password_enabled", props.HasSSHPassword != nil && *props.HasSSHPassword)
+		d.Set("sid", props.Sid)
+
+		if err := d.Set("permission_scope", flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)); err != nil {
+			return fmt.Errorf("setting `permission_scope`: %+v", err)
+		}
+	}
+
+	if err := d.Set("ssh_authorized_key", flattenStorageAccountLocalUserSSHAuthorizedKeys(keys.SSHAuthorizedKeys)); err != nil {
+		return fmt.Errorf("setting `ssh_authorized_key`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageAccountLocalUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName, id.LocalUserName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []interface{}) *[]storage.PermissionScope {
+	output := make([]storage.PermissionScope, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		output = append(output, storage.PermissionScope{
+			Service:      utils.String(v["service"].(string)),
+			ResourceName: utils.String(v["resource_name"].(string)),
+			Permissions:  utils.String(expandStorageAccountLocalUserPermissions(v["permissions"].([]interface{}))),
+		})
+	}
+
+	return &output
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]storage.PermissionScope) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		service := ""
+		if item.Service != nil {
+			service = *item.Service
+		}
+
+		resourceName := ""
+		if item.ResourceName != nil {
+			resourceName = *item.ResourceName
+		}
+
+		permissions := ""
+		if item.Permissions != nil {
+			permissions = *item.Permissions
+		}
+
+		output = append(output, map[string]interface{}{
+			"service":       service,
+			"resource_name": resourceName,
+			"permissions":   flattenStorageAccountLocalUserPermissions(permissions),
+		})
+	}
+
+	return output
+}
+
+func expandStorageAccountLocalUserPermissions(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+	v := input[0].(map[string]interface{})
+
+	// the API expects the permissions in the order `rwdlc`
+	permissions := ""
+	if v["read"].(bool) {
+		permissions += "r"
+	}
+	if v["write"].(bool) {
+		permissions += "w"
+	}
+	if v["delete"].(bool) {
+		permissions += "d"
+	}
+	if v["list"].(bool) {
+		permissions += "l"
+	}
+	if v["create"].(bool) {
+		permissions += "c"
+	}
+
+	return permissions
+}
+
+func flattenStorageAccountLocalUserPermissions(input string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"read":   strings.Contains(input, "r"),
+			"write":  strings.Contains(input, "w"),
+			"delete": strings.Contains(input, "d"),
+			"list":   strings.Contains(input, "l"),
+			"create": strings.Contains(input, "c"),
+		},
+	}
+}
+
+func expandStorageAccountLocalUserSSHAuthorizedKeys(input []interface{}) *[]storage.SSHPublicKey {
+	output := make([]storage.SSHPublicKey, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		key := storage.SSHPublicKey{
+			Key: utils.String(v["key"].(string)),
+		}
+		if description := v["description"].(string); description != "" {
+			key.Description = utils.String(description)
+		}
+
+		output = append(output, key)
+	}
+
+	return &output
+}
+
+func flattenStorageAccountLocalUserSSHAuthorizedKeys(input *[]storage.SSHPublicKey) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		key := ""
+		if item.Key != nil {
+			key = *item.Key
+		}
+
+		description := ""
+		if item.Description != nil {
+			description = *item.Description
+		}
+
+		output = append(output, map[string]interface{}{
+			"key":         key,
+			"description": description,
+		})
+	}
+
+	return output
+}
@@ -276,6 +276,18 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"local_user_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			// TODO: document this new field in 3.0
 			"allow_nested_items_to_be_public": {
 				Type:     pluginsdk.TypeBool,
@@ -983,6 +995,8 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	minimumTLSVersion := d.Get("min_tls_version").(string)
 	isHnsEnabled := d.Get("is_hns_enabled").(bool)
 	nfsV3Enabled := d.Get("nfsv3_enabled").(bool)
+	sftpEnabled := d.Get("sftp_enabled").(bool)
+	localUserEnabled := d.Get("local_user_enabled").(bool)
 	allowBlobPublicAccess := d.Get("allow_nested_items_to_be_public").(bool)
 	allowSharedKeyAccess := d.Get("shared_access_key_enabled").(bool)
 	defaultToOAuthAuthentication := d.Get("default_to_oauth_authentication").(bool)
@@ -1010,6 +1024,8 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			NetworkRuleSet:               expandStorageAccountNetworkRules(d, tenantId),
 			IsHnsEnabled:                 &isHnsEnabled,
 			EnableNfsV3:                  &nfsV3Enabled,
+			IsSftpEnabled:                &sftpEnabled,
+			IsLocalUserEnabled:           &localUserEnabled,
 			AllowSharedKeyAccess:         &allowSharedKeyAccess,
 			DefaultToOAuthAuthentication: &defaultToOAuthAuthentication,
 			AllowCrossTenantReplication:  &crossTenantReplication,
@@ -1079,6 +1095,10 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
 	}
 
+	if sftpEnabled && !isHnsEnabled {
+		return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+	}
+
 	// AccountTier must be Premium for FileStorage
 	if accountKind == string(storage.KindFileStorage) {
 		if string(parameters.Sku.Tier) == string(storage.SkuNameStandardLRS) {
@@ -1365,6 +1385,19 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		opts.AccountPropertiesUpdateParameters.AllowCrossTenantReplication = &crossTenantReplication
 	}
 
+	if d.HasChange("sftp_enabled") {
+		sftpEnabled := d.Get("sftp_enabled").(bool)
+		if sftpEnabled && !d.Get("is_hns_enabled").(bool) {
+			return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+		}
+		opts.AccountPropertiesUpdateParameters.IsSftpEnabled = &sftpEnabled
+	}
+
+	if d.HasChange("local_user_enabled") {
+		localUserEnabled := d.Get("local_user_enabled").(bool)
+		opts.AccountPropertiesUpdateParameters.IsLocalUserEnabled = &localUserEnabled
+	}
+
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
 		return fmt.Errorf("updating Azure Storage Account AllowSharedKeyAccess %q: %+v", id.Name, err)
 	}
@@ -1796,6 +1829,14 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("enable_https_traffic_only", props.EnableHTTPSTrafficOnly)
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
+		d.Set("sftp_enabled", props.IsSftpEnabled)
+
+		// `isLocalUserEnabled` defaults to true when unset
+		localUserEnabled := true
+		if props.IsLocalUserEnabled != nil {
+			localUserEnabled = *props.IsLocalUserEnabled
+		}
+		d.Set("local_user_enabled", localUserEnabled)
 
 		publicNetworkAccessEnabled := true
 		if props.PublicNetworkAccess == storage.PublicNetworkAccessDisabled {
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -128,6 +131,7 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"content_md5": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"source_uri"},
 			},
@@ -147,8 +151,88 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.StorageBlobIndexTags,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiry_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(shim.StorageBlobImmutabilityPolicyModeUnlocked),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(shim.StorageBlobImmutabilityPolicyModeLocked),
+								string(shim.StorageBlobImmutabilityPolicyModeUnlocked),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"legal_hold_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageBlobSourceContentMD5Diff),
+	}
+}
+
+// resourceStorageBlobSourceContentMD5Diff hashes the local `source` file so that changes made to the file in-place
+// (where the path is unchanged) are detected by comparing against the Content-MD5 stored on the Blob.
+func resourceStorageBlobSourceContentMD5Diff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source") {
+		return nil
+	}
+	source := d.Get("source").(string)
+	if source == "" {
+		return nil
+	}
+
+	// an explicitly configured `content_md5` takes precedence over the hash of the file
+	if v := d.GetRawConfig().GetAttr("content_md5"); !v.IsNull() {
+		return nil
 	}
+
+	contentMD5, err := computeFileMD5(source)
+	if err != nil {
+		// the file can be generated during the apply, in which case it'll be hashed on the next plan
+		log.Printf("[DEBUG] Unable to compute the MD5 of the `source` file for Blob %q: %+v", d.Get("name").(string), err)
+		return nil
+	}
+
+	existing, _ := d.GetChange("content_md5")
+	// Blobs uploaded prior to the Content-MD5 being tracked (e.g. Page blobs) have no hash to compare against,
+	// rather than forcing these to be recreated we only track changes once a hash is available
+	if d.Id() != "" && existing.(string) == "" {
+		return nil
+	}
+
+	if !strings.EqualFold(existing.(string), contentMD5) {
+		return d.SetNew("content_md5", contentMD5)
+	}
+
+	return nil
 }
 
 func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -284,6 +368,43 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updated Properties for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
+	blobsWrapper := shim.NewDataPlaneStorageBlobWrapper(blobsClient)
+
+	if d.HasChange("index_tags") {
+		log.Printf("[DEBUG] Updating Index Tags for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		tags := ExpandMetaData(d.Get("index_tags").(map[string]interface{}))
+		if err := blobsWrapper.UpdateIndexTags(ctx, id.AccountName, id.ContainerName, id.BlobName, tags); err != nil {
+			return fmt.Errorf("updating Index Tags for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Updated Index Tags for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	if d.HasChange("immutability_policy") {
+		log.Printf("[DEBUG] Updating Immutability Policy for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		policy, err := expandStorageBlobImmutabilityPolicy(d.Get("immutability_policy").([]interface{}))
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			if err := blobsWrapper.UpdateImmutabilityPolicy(ctx, id.AccountName, id.ContainerName, id.BlobName, *policy); err != nil {
+				return fmt.Errorf("updating Immutability Policy for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+		} else if !d.IsNewResource() {
+			if err := blobsWrapper.DeleteImmutabilityPolicy(ctx, id.AccountName, id.ContainerName, id.BlobName); err != nil {
+				return fmt.Errorf("deleting Immutability Policy for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+		}
+		log.Printf("[DEBUG] Updated Immutability Policy for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	if d.HasChange("legal_hold_enabled") {
+		log.Printf("[DEBUG] Updating Legal Hold for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		if err := blobsWrapper.UpdateLegalHold(ctx, id.AccountName, id.ContainerName, id.BlobName, d.Get("legal_hold_enabled").(bool)); err != nil {
+			return fmt.Errorf("updating Legal Hold for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Updated Legal Hold for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
 	if d.HasChange("metadata") {
 		log.Printf("[DEBUG] Updating MetaData for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		metaDataRaw := d.Get("metadata").(map[string]interface{})
@@ -367,6 +488,32 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("source_uri", props.CopySource)
 	}
 
+	blobsWrapper := shim.NewDataPlaneStorageBlobWrapper(blobsClient)
+
+	// Blob Index Tags aren't supported on all Storage Accounts (e.g. those with a Hierarchical Namespace or Premium Page
+	// Blobs), so a failure to retrieve these is only an error when they've been configured
+	indexTags, err := blobsWrapper.GetIndexTags(ctx, id.AccountName, id.ContainerName, id.BlobName)
+	if err != nil {
+		if _, ok := d.GetOk("index_tags"); ok {
+			return fmt.Errorf("retrieving Index Tags for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+
+		log.Printf("[DEBUG] Unable to retrieve Index Tags for Blob %q (Container %q / Account %q), assuming none are assigned: %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		indexTags = nil
+	}
+	if err := d.Set("index_tags", FlattenMetaData(indexTags)); err != nil {
+		return fmt.Errorf("setting `index_tags`: %+v", err)
+	}
+
+	immutability, err := blobsWrapper.GetImmutability(ctx, id.AccountName, id.ContainerName, id.BlobName)
+	if err != nil {
+		return fmt.Errorf("retrieving Immutability for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+	if err := d.Set("immutability_policy", flattenStorageBlobImmutabilityPolicy(immutability.ImmutabilityPolicy)); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
+	d.Set("legal_hold_enabled", immutability.HasLegalHold)
+
 	return nil
 }
 
@@ -393,6 +540,23 @@ func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
+	// a Legal Hold or an unexpired Immutability Policy prevents the Blob from being deleted - these exist for compliance
+	// purposes so are intentionally not removed here, instead they need to be removed explicitly first
+	blobsWrapper := shim.NewDataPlaneStorageBlobWrapper(blobsClient)
+	immutability, err := blobsWrapper.GetImmutability(ctx, id.AccountName, id.ContainerName, id.BlobName)
+	if err != nil {
+		return fmt.Errorf("retrieving Immutability for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+	if immutability.HasLegalHold {
+		return fmt.Errorf("deleting Blob %q (Container %q / Account %q): the Blob has a Legal Hold which must be removed (by setting `legal_hold_enabled` to `false`) before the Blob can be deleted", id.BlobName, id.ContainerName, id.AccountName)
+	}
+	if policy := immutability.ImmutabilityPolicy; policy != nil && policy.ExpiryTime.After(time.Now()) {
+		if policy.Mode == shim.StorageBlobImmutabilityPolicyModeLocked {
+			return fmt.Errorf("deleting Blob %q (Container %q / Account %q): the Blob has a Locked Immutability Policy and cannot be deleted until %s", id.BlobName, id.ContainerName, id.AccountName, policy.ExpiryTime.Format(time.RFC3339))
+		}
+		return fmt.Errorf("deleting Blob %q (Container %q / Account %q): the Blob has an Unlocked Immutability Policy which must be removed (by removing the `immutability_policy` block) before the Blob can be deleted", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
 	log.Printf("[INFO] Deleting Blob %q from Container %q / Storage Account %q", id.BlobName, id.ContainerName, id.AccountName)
 	input := blobs.DeleteInput{
 		DeleteSnapshots: true,
@@ -403,3 +567,33 @@ func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func expandStorageBlobImmutabilityPolicy(input []interface{}) (*shim.StorageBlobImmutabilityPolicy, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	expiryTime, err := time.Parse(time.RFC3339, v["expiry_time"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `expiry_time`: %+v", err)
+	}
+
+	return &shim.StorageBlobImmutabilityPolicy{
+		ExpiryTime: expiryTime,
+		Mode:       shim.StorageBlobImmutabilityPolicyMode(v["mode"].(string)),
+	}, nil
+}
+
+func flattenStorageBlobImmutabilityPolicy(input *shim.StorageBlobImmutabilityPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expiry_time": input.ExpiryTime.UTC().Format(time.RFC3339),
+			"mode":        string(input.Mode),
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,10 +10,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
 )
 
@@ -68,7 +71,66 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"immutability_period_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"locked": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"protected_append_writes_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"protected_append_writes_all_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"legal_hold": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"tags": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.StorageContainerLegalHoldTag,
+							},
+						},
+
+						"protected_append_writes_all_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			// TODO: support for ACL's
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -84,9 +146,41 @@ func resourceStorageContainer() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageContainerImmutabilityPolicyDiff),
 	}
 }
 
+func resourceStorageContainerImmutabilityPolicyDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	oldRaw, newRaw := d.GetChange("immutability_policy")
+	oldPolicy := expandStorageContainerImmutabilityPolicy(oldRaw.([]interface{}))
+	newPolicy := expandStorageContainerImmutabilityPolicy(newRaw.([]interface{}))
+
+	if newPolicy != nil && newPolicy.ProtectedAppendWritesEnabled && newPolicy.ProtectedAppendWritesAllEnabled {
+		return fmt.Errorf("only one of `protected_append_writes_enabled` and `protected_append_writes_all_enabled` can be enabled within the `immutability_policy` block")
+	}
+
+	if oldPolicy == nil || !oldPolicy.Locked {
+		return nil
+	}
+
+	// once an Immutability Policy has been Locked the only change which can be made is to extend the immutability period
+	if newPolicy == nil {
+		return fmt.Errorf("a Locked `immutability_policy` cannot be removed")
+	}
+	if !newPolicy.Locked {
+		return fmt.Errorf("a Locked `immutability_policy` cannot be unlocked")
+	}
+	if newPolicy.ImmutabilityPeriodInDays < oldPolicy.ImmutabilityPeriodInDays {
+		return fmt.Errorf("the `immutability_period_in_days` of a Locked `immutability_policy` can only be increased")
+	}
+	if newPolicy.ProtectedAppendWritesEnabled != oldPolicy.ProtectedAppendWritesEnabled || newPolicy.ProtectedAppendWritesAllEnabled != oldPolicy.ProtectedAppendWritesAllEnabled {
+		return fmt.Errorf("`protected_append_writes_enabled` and `protected_append_writes_all_enabled` cannot be changed for a Locked `immutability_policy`")
+	}
+
+	return nil
+}
+
 func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -132,6 +226,18 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("failed creating container: %+v", err)
 	}
 
+	if policy := expandStorageContainerImmutabilityPolicy(d.Get("immutability_policy").([]interface{})); policy != nil {
+		if err := client.UpdateImmutabilityPolicy(ctx, account.ResourceGroup, accountName, containerName, *policy); err != nil {
+			return fmt.Errorf("setting the Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, account.ResourceGroup, err)
+		}
+	}
+
+	if legalHold := expandStorageContainerLegalHold(d.Get("legal_hold").([]interface{})); legalHold != nil {
+		if err := client.UpdateLegalHold(ctx, account.ResourceGroup, accountName, containerName, *legalHold); err != nil {
+			return fmt.Errorf("setting the Legal Hold for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, account.ResourceGroup, err)
+		}
+	}
+
 	d.SetId(id)
 	return resourceStorageContainerRead(d, meta)
 }
@@ -182,6 +288,32 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
+	if d.HasChange("immutability_policy") {
+		log.Printf("[DEBUG] Updating the Immutability Policy for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
+		if policy := expandStorageContainerImmutabilityPolicy(d.Get("immutability_policy").([]interface{})); policy != nil {
+			if err := client.UpdateImmutabilityPolicy(ctx, account.ResourceGroup, id.AccountName, id.Name, *policy); err != nil {
+				return fmt.Errorf("updating the Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+			}
+		} else {
+			if err := client.DeleteImmutabilityPolicy(ctx, account.ResourceGroup, id.AccountName, id.Name); err != nil {
+				return fmt.Errorf("deleting the Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+			}
+		}
+		log.Printf("[DEBUG] Updated the Immutability Policy for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
+	}
+
+	if d.HasChange("legal_hold") {
+		log.Printf("[DEBUG] Updating the Legal Hold for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
+		legalHold := shim.StorageContainerLegalHold{}
+		if v := expandStorageContainerLegalHold(d.Get("legal_hold").([]interface{})); v != nil {
+			legalHold = *v
+		}
+		if err := client.UpdateLegalHold(ctx, account.ResourceGroup, id.AccountName, id.Name, legalHold); err != nil {
+			return fmt.Errorf("updating the Legal Hold for Container %q (Storage Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated the Legal Hold for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
+	}
+
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -232,6 +364,22 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
+	immutabilityPolicy, err := client.GetImmutabilityPolicy(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Immutability Policy for Container %q (Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+	if err := d.Set("immutability_policy", flattenStorageContainerImmutabilityPolicy(immutabilityPolicy)); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
+
+	legalHold, err := client.GetLegalHold(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Legal Hold for Container %q (Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+	if err := d.Set("legal_hold", flattenStorageContainerLegalHold(legalHold)); err != nil {
+		return fmt.Errorf("setting `legal_hold`: %+v", err)
+	}
+
 	resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
@@ -286,3 +434,57 @@ func flattenStorageContainerAccessLevel(input containers.AccessLevel) string {
 
 	return string(input)
 }
+
+func expandStorageContainerImmutabilityPolicy(input []interface{}) *shim.StorageContainerImmutabilityPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &shim.StorageContainerImmutabilityPolicy{
+		ImmutabilityPeriodInDays:        v["immutability_period_in_days"].(int),
+		Locked:                          v["locked"].(bool),
+		ProtectedAppendWritesEnabled:    v["protected_append_writes_enabled"].(bool),
+		ProtectedAppendWritesAllEnabled: v["protected_append_writes_all_enabled"].(bool),
+	}
+}
+
+func flattenStorageContainerImmutabilityPolicy(input *shim.StorageContainerImmutabilityPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"immutability_period_in_days":         input.ImmutabilityPeriodInDays,
+			"locked":                              input.Locked,
+			"protected_append_writes_enabled":     input.ProtectedAppendWritesEnabled,
+			"protected_append_writes_all_enabled": input.ProtectedAppendWritesAllEnabled,
+		},
+	}
+}
+
+func expandStorageContainerLegalHold(input []interface{}) *shim.StorageContainerLegalHold {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &shim.StorageContainerLegalHold{
+		Tags:                            *utils.ExpandStringSlice(v["tags"].(*pluginsdk.Set).List()),
+		ProtectedAppendWritesAllEnabled: v["protected_append_writes_all_enabled"].(bool),
+	}
+}
+
+func flattenStorageContainerLegalHold(input *shim.StorageContainerLegalHold) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"tags":                                utils.FlattenStringSlice(&input.Tags),
+			"protected_append_writes_all_enabled": input.ProtectedAppendWritesAllEnabled,
+		},
+	}
+}
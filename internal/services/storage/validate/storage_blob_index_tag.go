@@ -21,3 +21,20 @@ func StorageBlobIndexTagValue(v interface{}, k string) (warnings []string, error
 	}
 	return warnings, errors
 }
+
+func StorageBlobIndexTags(v interface{}, k string) (warnings []string, errors []error) {
+	tags := v.(map[string]interface{})
+	if len(tags) > 10 {
+		errors = append(errors, fmt.Errorf("a maximum of 10 %q can be specified, got %d", k, len(tags)))
+	}
+
+	for name, value := range tags {
+		_, nameErrors := StorageBlobIndexTagName(name, fmt.Sprintf("%s.%s", k, name))
+		errors = append(errors, nameErrors...)
+
+		_, valueErrors := StorageBlobIndexTagValue(value.(string), fmt.Sprintf("%s.%s", k, name))
+		errors = append(errors, valueErrors...)
+	}
+
+	return warnings, errors
+}
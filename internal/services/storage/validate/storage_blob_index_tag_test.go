@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -51,3 +52,49 @@ func TestStorageBlobIndexTagValue(t *testing.T) {
 		}
 	}
 }
+
+func TestStorageBlobIndexTags(t *testing.T) {
+	tooManyTags := make(map[string]interface{})
+	for i := 0; i < 11; i++ {
+		tooManyTags[fmt.Sprintf("tag%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"environment": "production",
+				"project":     "",
+			},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				strings.Repeat("w", 129): "value",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"environment": strings.Repeat("w", 257),
+			},
+			Valid: false,
+		},
+		{
+			Input: tooManyTags,
+			Valid: false,
+		},
+	}
+	for _, tc := range testCases {
+		_, errors := StorageBlobIndexTags(tc.Input, "index_tags")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Fatalf("expected %+v to be valid %t but got %t", tc.Input, tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageContainerLegalHoldTag(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// the API normalizes Legal Hold Tags to lower-case, so we only accept lower-case values to avoid a diff
+	if !regexp.MustCompile(`^[a-z0-9]{3,23}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 23 lowercase alphanumeric characters: %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,33 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageContainerLegalHoldTag(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"legalhold2022",
+		strings.Repeat("w", 23),
+	}
+	for _, v := range validNames {
+		_, errors := StorageContainerLegalHoldTag(v, "tags")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Legal Hold Tag: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ab",
+		"LegalHold",
+		"legal-hold",
+		strings.Repeat("w", 24),
+	}
+	for _, v := range invalidNames {
+		if _, errors := StorageContainerLegalHoldTag(v, "tags"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Legal Hold Tag", v)
+		}
+	}
+}